@@ -0,0 +1,44 @@
+// Package main runs a command-line-interface game of wordle where the host scores guesses to keep the most answers possible, like Absurdle
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/word_lists"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/absurdle"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+)
+
+// main hosts a game on the command-line using stdin and stdout
+func main() {
+	var wl word_lists.Flags
+	numLetters := flag.Int("length", words.DefaultNumLetters, "the number of letters in each word")
+	wl.AddListFlags(flag.CommandLine)
+	alphabet := flag.String("alphabet", "", "the alphabet of the letters of the words, such as spanish or german (defaults to english)")
+	flag.Parse()
+
+	a, err := char_set.AlphabetNamed(*alphabet)
+	if err != nil {
+		panic(err)
+	}
+	lists, err := wl.Lists(*numLetters)
+	if err != nil {
+		panic(err)
+	}
+	h := absurdle.New(lists.Answers, *numLetters, a)
+
+	rw := struct {
+		io.Reader
+		io.Writer
+	}{
+		Reader: os.Stdin,
+		Writer: os.Stdout,
+	}
+	if err := h.Play(rw, lists.Guesses); err != nil {
+		panic(fmt.Errorf("running absurdle: %v", err))
+	}
+}
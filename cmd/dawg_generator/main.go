@@ -0,0 +1,30 @@
+package main
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/dawg"
+)
+
+// main reads the word list from standard input and writes it as a DAWG to standard output, to be embedded in the programs
+func main() {
+	if err := generate(os.Stdin, os.Stdout); err != nil {
+		panic(err)
+	}
+}
+
+func generate(r io.Reader, w io.Writer) error {
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	d := dawg.New(strings.Fields(string(text)))
+	data, err := d.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
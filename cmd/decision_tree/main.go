@@ -0,0 +1,67 @@
+// Package main builds a tree of the wordle guesses to make after each score, which the cheaters can load to look up guesses instead of searching for them
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/word_lists"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+)
+
+// main builds a tree that solves every answer and writes it to stdout, with a summary of its guesses on stderr
+func main() {
+	var goal, opener, format string
+	var wl word_lists.Flags
+	var numLetters int
+	var b decision_tree.Builder
+	flag.IntVar(&numLetters, "length", words.DefaultNumLetters, "the number of letters in each word")
+	flag.StringVar(&goal, "goal", string(decision_tree.Average), fmt.Sprintf("what to minimize: %v guesses or the %v number of guesses to solve an answer", decision_tree.Average, decision_tree.WorstCase))
+	flag.StringVar(&opener, "opener", "", "the first guess of the tree, picked like the other guesses if empty")
+	flag.IntVar(&b.Candidates, "candidates", 10, "the number of the best ranked guesses to try at each step, all guesses are tried if not positive (slow)")
+	flag.BoolVar(&b.FromAll, "from-all", false, "try every word as a guess, not just the possible words")
+	flag.IntVar(&b.MaxGuesses, "max-guesses", 6, "the number of guesses allowed to find each answer")
+	flag.StringVar(&format, "format", "text", "the format to write the tree in: text or json")
+	wl.AddListFlags(flag.CommandLine)
+	flag.Parse()
+
+	lists, err := wl.Lists(numLetters)
+	if err != nil {
+		log.Fatal(err)
+	}
+	b.Goal = decision_tree.Goal(goal)
+	if len(opener) != 0 {
+		g := guess.New(opener)
+		if err := g.Validate(lists.Guesses, numLetters); err != nil {
+			log.Fatalf("invalid opener: %v", err)
+		}
+		b.Opener = g
+	}
+
+	tree, cost, err := b.Build(lists.Answers, lists.Guesses)
+	if err != nil {
+		log.Fatalf("building tree: %v", err)
+	}
+	var data []byte
+	switch format {
+	case "text":
+		data, err = tree.MarshalText()
+	case "json":
+		data, err = json.Marshal(tree)
+		data = append(data, '\n')
+	default:
+		log.Fatalf("unknown format: %q", format)
+	}
+	if err != nil {
+		log.Fatalf("writing tree: %v", err)
+	}
+	os.Stdout.Write(data)
+	fmt.Fprintf(os.Stderr, "answers: %v\n", cost.Answers)
+	fmt.Fprintf(os.Stderr, "average guesses: %.4f\n", cost.Average())
+	fmt.Fprintf(os.Stderr, "worst guesses: %v\n", cost.Worst)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/pattern_search"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/word_lists"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+)
+
+func main() {
+	var q pattern_search.Query
+	var wl word_lists.Flags
+	flag.StringVar(&q.Pattern, "pattern", "", "the letters of the words: ? matches a letter and * matches any number of letters, such as c?t*")
+	flag.StringVar(&q.Required, "required", "", "letters that must be in the words")
+	flag.StringVar(&q.Excluded, "excluded", "", "letters that must not be in the words")
+	flag.IntVar(&q.MinLength, "min-length", 0, "the fewest letters of the words")
+	flag.IntVar(&q.MaxLength, "max-length", 0, "the most letters of the words (0 is no limit)")
+	flag.StringVar(&q.Regexp, "regexp", "", "a regular expression that the words must match, such as ^c.*t$")
+	alphabet := flag.String("alphabet", "", "the alphabet of the letters of the words, such as spanish or german (defaults to english)")
+	wl.AddDictionaryFlags(flag.CommandLine)
+	flag.Parse()
+	a, err := char_set.AlphabetNamed(*alphabet)
+	if err != nil {
+		panic(err)
+	}
+	q.Alphabet = a
+	lexicon, err := wl.Lexicon()
+	if err != nil {
+		panic(err)
+	}
+	if err := runPatternSearch(os.Stdout, q, lexicon); err != nil {
+		panic(err)
+	}
+}
+
+func runPatternSearch(w io.Writer, q pattern_search.Query, lexicon *words.Lexicon) error {
+	found, err := q.Search(lexicon)
+	if err != nil {
+		return err
+	}
+	for _, word := range found {
+		fmt.Fprintln(w, word)
+	}
+	fmt.Fprintf(w, "%v words\n", len(found))
+	return nil
+}
@@ -6,12 +6,20 @@ import (
 	"io"
 	"os"
 	"strings"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/word_lists"
 )
 
 type Config struct {
-	fs   *flag.FlagSet
-	Host string
-	Port string
+	fs              *flag.FlagSet
+	Host            string
+	Port            string
+	Dictionaries    string
+	GuessesFile     string
+	AnswersFile     string
+	FrequenciesFile string
+	PastAnswersFile string
+	TreeFile        string
 }
 
 func New() (*Config, error) {
@@ -35,6 +43,12 @@ func newConfig(flagSetErrorHandling flag.ErrorHandling, out io.Writer, args ...s
 	var cfg Config
 	fs.StringVar(&cfg.Host, "host", "", "the server to run on (usually leave empty)")
 	fs.StringVar(&cfg.Port, "port", "8000", "the port to run on (required)")
+	fs.StringVar(&cfg.Dictionaries, "dictionaries", "", word_lists.DictionariesUsage)
+	fs.StringVar(&cfg.GuessesFile, "guesses-file", "", word_lists.GuessesUsage)
+	fs.StringVar(&cfg.AnswersFile, "answers-file", "", "a file of the words that can be wordle answers (all words are used if empty)")
+	fs.StringVar(&cfg.FrequenciesFile, "frequencies-file", "", "a file with a word and how often it is used on each line, used to rank the possible wordle answers")
+	fs.StringVar(&cfg.PastAnswersFile, "past-answers-file", "", "a file with the date and answer of an earlier puzzle on each line, used to rule out answers that were already used")
+	fs.StringVar(&cfg.TreeFile, "tree-file", "", "a file of a decision tree made by decision_tree, used to look up the suggested wordle guesses")
 	cfg.fs = fs
 
 	if err := cfg.parse(args...); err != nil {
@@ -46,10 +46,22 @@ func TestParse(t *testing.T) {
 			name: "all args",
 			args: []string{
 				"-port=1",
+				"-dictionaries=lists",
+				"-guesses-file=guesses.txt",
+				"-answers-file=answers.txt",
+				"-frequencies-file=frequencies.txt",
+				"-past-answers-file=past.txt",
+				"-tree-file=tree.txt",
 			},
 			wantOk: true,
 			want: Config{
-				Port: "1",
+				Port:            "1",
+				Dictionaries:    "lists",
+				GuessesFile:     "guesses.txt",
+				AnswersFile:     "answers.txt",
+				FrequenciesFile: "frequencies.txt",
+				PastAnswersFile: "past.txt",
+				TreeFile:        "tree.txt",
 			},
 		},
 		{
@@ -59,10 +71,22 @@ func TestParse(t *testing.T) {
 			},
 			env: [][]string{
 				{"PORT", "1"},
+				{"DICTIONARIES", "lists"},
+				{"GUESSES_FILE", "guesses.txt"},
+				{"ANSWERS_FILE", "answers.txt"},
+				{"FREQUENCIES_FILE", "frequencies.txt"},
+				{"PAST_ANSWERS_FILE", "past.txt"},
+				{"TREE_FILE", "tree.txt"},
 			},
 			wantOk: true,
 			want: Config{
-				Port: "1",
+				Port:            "1",
+				Dictionaries:    "lists",
+				GuessesFile:     "guesses.txt",
+				AnswersFile:     "answers.txt",
+				FrequenciesFile: "frequencies.txt",
+				PastAnswersFile: "past.txt",
+				TreeFile:        "tree.txt",
 			},
 		},
 	}
@@ -4,10 +4,12 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
 
 	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/cmd/server/config"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/server"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
 )
 
 func main() {
@@ -16,7 +18,58 @@ func main() {
 		log.Fatalf("parsing configuration: %v", err)
 	}
 
-	h := server.NewHandler(words.WordsTextFile)
+	dictionaries, err := words.LoadDictionaries(cfg.Dictionaries)
+	if err != nil {
+		log.Fatalf("loading dictionaries: %v", err)
+	}
+	if len(cfg.GuessesFile) != 0 {
+		if err := dictionaries.LoadDefault(cfg.GuessesFile); err != nil {
+			log.Fatalf("loading dictionaries: %v", err)
+		}
+	}
+	lexicon, err := dictionaries.Lexicon(words.DefaultDictionary)
+	if err != nil {
+		log.Fatalf("loading dictionaries: %v", err)
+	}
+	wt := server.WordsText{
+		WordsLexicon: lexicon,
+		Dictionaries: dictionaries,
+	}
+	if len(cfg.AnswersFile) != 0 {
+		text, err := os.ReadFile(cfg.AnswersFile)
+		if err != nil {
+			log.Fatalf("reading answers: %v", err)
+		}
+		wt.Answers = string(text)
+	}
+	if len(cfg.FrequenciesFile) != 0 {
+		text, err := os.ReadFile(cfg.FrequenciesFile)
+		if err != nil {
+			log.Fatalf("reading word frequencies: %v", err)
+		}
+		wt.Frequencies = string(text)
+	}
+	if len(cfg.PastAnswersFile) != 0 {
+		text, err := os.ReadFile(cfg.PastAnswersFile)
+		if err != nil {
+			log.Fatalf("reading past answers: %v", err)
+		}
+		wt.PastAnswers = string(text)
+	}
+	if len(cfg.TreeFile) != 0 {
+		text, err := os.ReadFile(cfg.TreeFile)
+		if err != nil {
+			log.Fatalf("reading decision tree: %v", err)
+		}
+		if wt.Tree, err = decision_tree.Parse(text); err != nil {
+			log.Fatalf("parsing decision tree: %v", err)
+		}
+	}
+
+	h, err := server.NewHandler(wt)
+	if err != nil {
+		log.Fatalf("creating handler: %v", err)
+	}
 	addr := net.JoinHostPort(cfg.Host, cfg.Port)
 	log.Println("Serving resume site at http://127.0.0.1" + addr)
 	log.Println("Press Ctrl-C to stop")
@@ -1,41 +1,58 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"io"
 	"os"
+	"unicode/utf8"
 
 	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/spelling_bee"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/word_lists"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
 )
 
 func main() {
-	runSpellingBee(os.Stdin, os.Stdout)
+	var wl word_lists.Flags
+	alphabet := flag.String("alphabet", "", "the alphabet of the letters of the words, such as spanish or german (defaults to english)")
+	wl.AddDictionaryFlags(flag.CommandLine)
+	flag.Parse()
+	a, err := char_set.AlphabetNamed(*alphabet)
+	if err != nil {
+		panic(err)
+	}
+	lexicon, err := wl.Lexicon()
+	if err != nil {
+		panic(err)
+	}
+	runSpellingBee(os.Stdin, os.Stdout, a, lexicon)
 }
 
-func runSpellingBee(r io.Reader, w io.Writer) {
+func runSpellingBee(r io.Reader, w io.Writer, a *char_set.Alphabet, l *words.Lexicon) {
 	var sb spelling_bee.SpellingBee
 	sb.MinLength = 4
+	sb.Alphabet = a
 
 	fmt.Fprint(w, "enter central letter: ")
 	fmt.Fscan(r, &sb.OtherLetters)
-	if len(sb.OtherLetters) != 1 {
+	letters := []rune(sb.OtherLetters)
+	if len(letters) != 1 {
 		fmt.Fprintln(w, "expected 1 central letter")
 		return
 	}
-	sb.CentralLetter = rune(sb.OtherLetters[0])
+	sb.CentralLetter = letters[0]
 
 	fmt.Print("enter other letters: ")
 	fmt.Fscan(r, &sb.OtherLetters)
 
-	if len(sb.OtherLetters) != 6 {
+	if utf8.RuneCountInString(sb.OtherLetters) != 6 {
 		fmt.Fprintln(w, "expected 6 other letters")
 		return
 	}
 
 	fmt.Println("available words: (score first)")
-	words := sb.Words(words.WordsTextFile)
-	for _, v := range words {
+	for _, v := range sb.LexiconWords(l) {
 		fmt.Fprint(w, v.Score, " ", v.Value)
 		if v.IsPangram {
 			fmt.Print(w, " (PANGRAM!)")
@@ -0,0 +1,68 @@
+// Package main plays wordle against every word to measure how well a guess strategy solves it
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/word_lists"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/solver"
+)
+
+// main plays a game for each word and prints a summary of how many guesses were needed
+func main() {
+	strategyNames := make([]string, 0, len(solver.Strategies))
+	for name := range solver.Strategies {
+		strategyNames = append(strategyNames, name)
+	}
+	slices.Sort(strategyNames)
+
+	var strategyName, opener string
+	var wl word_lists.Flags
+	var numLetters int
+	var s solver.Solver
+	flag.IntVar(&numLetters, "length", words.DefaultNumLetters, "the number of letters in each word")
+	flag.StringVar(&strategyName, "strategy", "entropy", "the strategy to pick guesses with: "+strings.Join(strategyNames, ", "))
+	alphabet := flag.String("alphabet", "", "the alphabet of the letters of the words, such as spanish or german (defaults to english)")
+	flag.StringVar(&opener, "opener", "", "the first guess of every game, picked by the strategy if empty")
+	flag.IntVar(&s.MaxGuesses, "max-guesses", 6, "the number of guesses allowed to find each answer")
+	flag.IntVar(&s.Parallel, "parallel", 0, "the number of games to play at once, defaults to the number of CPUs")
+	wl.AddListFlags(flag.CommandLine)
+	flag.Parse()
+
+	lists, err := wl.Lists(numLetters)
+	if err != nil {
+		log.Fatal(err)
+	}
+	strategy, ok := solver.Strategies[strategyName]
+	if !ok {
+		log.Fatalf("unknown strategy: %q", strategyName)
+	}
+	s.Strategy = strategy
+	a, err := char_set.AlphabetNamed(*alphabet)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.Alphabet = a
+	if len(opener) != 0 {
+		g := guess.New(opener)
+		if err := g.Validate(lists.Guesses, numLetters); err != nil {
+			log.Fatalf("invalid opener: %v", err)
+		}
+		s.Opener = g
+	}
+
+	r, err := s.Benchmark(lists.Answers, lists.Guesses)
+	if err != nil {
+		log.Fatalf("running benchmark: %v", err)
+	}
+	fmt.Fprintf(os.Stdout, "strategy: %v\n", strategyName)
+	r.Print(os.Stdout)
+}
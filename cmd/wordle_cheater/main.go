@@ -2,16 +2,114 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/word_lists"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
 )
 
 // main runs wordle-cheater on the command-line using stdin and stdout
 func main() {
+	var cfg cheater.Config
+	var wl word_lists.Flags
+	flag.IntVar(&cfg.NumLetters, "length", words.DefaultNumLetters, "the number of letters in each word")
+	flag.IntVar(&cfg.SuggestionCount, "suggestions", 5, "the number of recommended guesses to show after each turn")
+	flag.BoolVar(&cfg.SuggestFromAll, "suggest-from-all", false, "rank every word as a guess, not just the possible words")
+	suggestStrategy := flag.String("suggest-strategy", string(recommend.Entropy), fmt.Sprintf("how to rank the suggested guesses: %v for the most expected information or %v for the fewest words left in the worst case", recommend.Entropy, recommend.Minimax))
+	flag.BoolVar(&cfg.HardMode, "hard", false, "require guesses to use the correct and almost correct letters of previous scores")
+	flag.IntVar(&cfg.Boards, "boards", 1, "the number of answers to solve with the same guesses, such as 2 for Dordle or 4 for Quordle")
+	flag.BoolVar(&cfg.ShowStats, "stats", false, "show how common each letter is at each position of the possible words after each turn")
+	flag.BoolVar(&cfg.WhyNot, "why-not", false, "prompt for words to explain why they can not be the answer after each turn")
+	flag.StringVar(&cfg.Constraints.Correct, "correct", "", "the letters of the answer that are known at their positions, with ? at the others, such as ??r??")
+	flag.StringVar(&cfg.Constraints.Almost, "almost", "", "space-separated patterns of letters in the answer, but not at their positions, such as \"e???? ?a???\"")
+	flag.StringVar(&cfg.Constraints.Excluded, "excluded", "", "the letters that are not in the answer, such as st")
+	resumePath := flag.String("resume", "", "the file of a saved game to continue")
+	savePath := flag.String("save", "", "the file to save the game to after each turn")
+	wl.AddListFlags(flag.CommandLine)
+	frequenciesPath := flag.String("frequencies", "", "a file with a word and how often it is used on each line, used to rank the possible words"+word_lists.DefaultOnly)
+	pastAnswersPath := flag.String("past-answers", "", "a file with the date and answer of an earlier puzzle on each line, used to rule out answers that were already used"+word_lists.DefaultOnly)
+	alphabet := flag.String("alphabet", "", "the alphabet of the letters of the words, such as spanish or german (defaults to english)")
+	date := flag.String("date", "", "the date of the puzzle, such as 2022-01-02, used with -past-answers (defaults to today)")
+	sharePath := flag.String("share", "", "a file with an emoji grid shared from a game with the same answer, used to rule out answers")
+	treePath := flag.String("tree", "", "a file of a decision tree made by decision_tree, used to look up the guesses to make"+word_lists.DefaultOnly)
+	flag.Parse()
+
+	lexicon, err := wl.Lexicon()
+	if err != nil {
+		panic(err)
+	}
+	if len(*resumePath) != 0 {
+		text, err := os.ReadFile(*resumePath)
+		if err != nil {
+			panic(fmt.Errorf("reading saved game: %v", err))
+		}
+		if err := cfg.Resume.UnmarshalText(text); err != nil {
+			panic(fmt.Errorf("parsing saved game: %v", err))
+		}
+	}
+	if cfg.AnswersText, err = wl.ReadDefaultFile(wl.AnswersPath); err != nil {
+		panic(fmt.Errorf("reading answers: %v", err))
+	}
+	if cfg.FrequenciesText, err = wl.ReadDefaultFile(*frequenciesPath); err != nil {
+		panic(fmt.Errorf("reading word frequencies: %v", err))
+	}
+	if cfg.PastAnswersText, err = wl.ReadDefaultFile(*pastAnswersPath); err != nil {
+		panic(fmt.Errorf("reading past answers: %v", err))
+	}
+	if cfg.SuggestStrategy, err = recommend.ParseStrategy(*suggestStrategy); err != nil {
+		panic(err)
+	}
+	a, err := char_set.AlphabetNamed(*alphabet)
+	if err != nil {
+		panic(err)
+	}
+	cfg.Alphabet = a
+	if len(*date) != 0 {
+		d, err := time.Parse(time.DateOnly, *date)
+		if err != nil {
+			panic(fmt.Errorf("parsing date: %v", err))
+		}
+		cfg.Date = d
+	}
+	if len(*sharePath) != 0 {
+		text, err := os.ReadFile(*sharePath)
+		if err != nil {
+			panic(fmt.Errorf("reading shared grid: %v", err))
+		}
+		cfg.Share, err = score.ParseShare(string(text))
+		if err != nil {
+			panic(fmt.Errorf("parsing shared grid: %v", err))
+		}
+	}
+	treeText, err := wl.ReadDefaultFile(*treePath)
+	if err != nil {
+		panic(fmt.Errorf("reading decision tree: %v", err))
+	}
+	if len(treeText) != 0 {
+		if cfg.Tree, err = decision_tree.Parse([]byte(treeText)); err != nil {
+			panic(fmt.Errorf("parsing decision tree: %v", err))
+		}
+	}
+	if len(*savePath) != 0 {
+		cfg.Save = func(rs result.Results) error {
+			text, err := rs.MarshalText()
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(*savePath, text, 0644)
+		}
+	}
+
 	rw := struct {
 		io.Reader
 		io.Writer
@@ -19,7 +117,7 @@ func main() {
 		Reader: os.Stdin,
 		Writer: os.Stdout,
 	}
-	if err := cheater.RunWordleCheater(rw, words.WordsTextFile); err != nil {
+	if err := cheater.RunWordleCheater(rw, lexicon, cfg); err != nil {
 		panic(fmt.Errorf("running wordle: %v", err))
 	}
 }
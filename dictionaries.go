@@ -0,0 +1,141 @@
+package words
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Dictionaries are the texts of word lists, by name.
+// The default dictionary is the embedded words unless it has its own text.
+type Dictionaries map[string]string
+
+// DefaultDictionary is the name of the embedded word list, which is used when no dictionary is picked
+const DefaultDictionary = "default"
+
+// dictionaryExt is the extension of the word list files that are loaded from a directory
+const dictionaryExt = ".txt"
+
+// LoadDictionaries loads the word lists of the files and directories of the comma-separated paths.
+// A path can be prefixed with its name: "spanish=/usr/share/dict/spanish".
+// Otherwise, a file is named by its base name without the extension: "/usr/share/dict/spanish.txt" is named "spanish".
+// Each .txt file of a directory is loaded.
+func LoadDictionaries(paths string) (Dictionaries, error) {
+	d := make(Dictionaries)
+	for _, path := range strings.Split(paths, ",") {
+		path = strings.TrimSpace(path)
+		if len(path) == 0 {
+			continue
+		}
+		name, path, named := strings.Cut(path, "=")
+		if !named {
+			path = name
+			name = ""
+		}
+		if err := d.load(name, path); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// load adds the word list of the file or the word lists of the directory
+func (d Dictionaries) load(name, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("loading dictionary: %w", err)
+	}
+	if !info.IsDir() {
+		return d.loadFile(name, path)
+	}
+	if len(name) != 0 {
+		return fmt.Errorf("loading dictionary: directory %q can not be named", path)
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("loading dictionaries: %w", err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != dictionaryExt {
+			continue
+		}
+		if err := d.loadFile("", filepath.Join(path, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadFile adds the word list of the file, named by the file if the name is empty
+func (d Dictionaries) loadFile(name, path string) error {
+	if len(name) == 0 {
+		base := filepath.Base(path)
+		name = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+	if _, ok := d[name]; ok || name == DefaultDictionary {
+		return fmt.Errorf("loading dictionary %q from %v: a dictionary already has the name", name, path)
+	}
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("loading dictionary %q: %w", name, err)
+	}
+	d[name] = string(text)
+	return nil
+}
+
+// IsDefaultDictionary determines if the name picks the default dictionary, which the empty name also picks
+func IsDefaultDictionary(name string) bool {
+	return len(name) == 0 || name == DefaultDictionary
+}
+
+// LoadDefault replaces the embedded word list of the default dictionary with the word list of the file
+func (d Dictionaries) LoadDefault(path string) error {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("loading guesses: %w", err)
+	}
+	d[DefaultDictionary] = string(text)
+	return nil
+}
+
+// Names lists the names of the dictionaries, with the default first
+func (d Dictionaries) Names() []string {
+	names := make([]string, 0, len(d)+1)
+	for name := range d {
+		if name != DefaultDictionary {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Insert(names, 0, DefaultDictionary)
+}
+
+// Text is the word list of the dictionary with the name.  The default dictionary is used if the name is empty.
+func (d Dictionaries) Text(name string) (string, error) {
+	if IsDefaultDictionary(name) {
+		name = DefaultDictionary
+	}
+	text, ok := d[name]
+	switch {
+	case ok:
+		return text, nil
+	case name == DefaultDictionary:
+		return EmbeddedText(), nil
+	}
+	return "", fmt.Errorf("unknown dictionary: %q", name)
+}
+
+// Lexicon is the indexed word list of the dictionary with the name.  The default dictionary is used if the name is empty.
+// The embedded words are indexed from their DAWG, without decoding their text.
+func (d Dictionaries) Lexicon(name string) (*Lexicon, error) {
+	if _, ok := d[DefaultDictionary]; !ok && IsDefaultDictionary(name) {
+		return EmbeddedLexicon(), nil
+	}
+	text, err := d.Text(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewLexicon(text), nil
+}
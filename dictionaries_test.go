@@ -0,0 +1,191 @@
+package words
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadDictionaries(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, text string) string {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			t.Fatalf("writing file: %v", err)
+		}
+		return path
+	}
+	spanish := writeFile("spanish.txt", "niños señor")
+	writeFile("lists/german.txt", "größe")
+	writeFile("lists/portuguese.txt", "ações")
+	writeFile("lists/README.md", "not a word list")
+	tests := []struct {
+		name    string
+		paths   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "only embedded",
+			want: []string{DefaultDictionary},
+		},
+		{
+			name:  "file",
+			paths: spanish,
+			want:  []string{DefaultDictionary, "spanish"},
+		},
+		{
+			name:  "named file",
+			paths: "español=" + spanish,
+			want:  []string{DefaultDictionary, "español"},
+		},
+		{
+			name:  "directory",
+			paths: filepath.Join(dir, "lists") + ", " + spanish,
+			want:  []string{DefaultDictionary, "german", "portuguese", "spanish"},
+		},
+		{
+			name:    "missing file",
+			paths:   filepath.Join(dir, "klingon.txt"),
+			wantErr: true,
+		},
+		{
+			name:    "duplicate name",
+			paths:   spanish + "," + spanish,
+			wantErr: true,
+		},
+		{
+			name:    "named default",
+			paths:   DefaultDictionary + "=" + spanish,
+			wantErr: true,
+		},
+		{
+			name:    "named directory",
+			paths:   "all=" + filepath.Join(dir, "lists"),
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := LoadDictionaries(test.paths)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got.Names()):
+				t.Errorf("names not equal:\nwanted: %v\ngot:    %v", test.want, got.Names())
+			}
+		})
+	}
+}
+
+func TestDictionariesText(t *testing.T) {
+	d := Dictionaries{DefaultDictionary: "apple", "spanish": "niños"}
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{"", "apple", false},
+		{DefaultDictionary, "apple", false},
+		{"spanish", "niños", false},
+		{"klingon", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := d.Text(test.name)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case test.want != got:
+				t.Errorf("wanted %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestDictionariesTextEmbedded(t *testing.T) {
+	got, err := Dictionaries{"spanish": "niños"}.Text("")
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case got != EmbeddedText():
+		t.Errorf("wanted embedded words to be the default dictionary")
+	}
+}
+
+func TestDictionariesLoadDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "guesses.txt")
+	if err := os.WriteFile(path, []byte("apple berry"), 0o644); err != nil {
+		t.Fatalf("writing file: %v", err)
+	}
+	d := Dictionaries{DefaultDictionary: "crane", "spanish": "niños"}
+	if err := d.LoadDefault(path); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	want := Dictionaries{DefaultDictionary: "apple berry", "spanish": "niños"}
+	if !reflect.DeepEqual(want, d) {
+		t.Errorf("not equal:\nwanted: %v\ngot:    %v", want, d)
+	}
+	if err := d.LoadDefault(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Errorf("wanted error loading missing file")
+	}
+}
+
+func TestIsDefaultDictionary(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"", true},
+		{DefaultDictionary, true},
+		{"spanish", false},
+	}
+	for _, test := range tests {
+		if want, got := test.want, IsDefaultDictionary(test.name); want != got {
+			t.Errorf("IsDefaultDictionary(%q): wanted %v, got %v", test.name, want, got)
+		}
+	}
+}
+
+func TestDictionariesLexicon(t *testing.T) {
+	tests := []struct {
+		name string
+		Dictionaries
+		dictionary string
+		want       *Lexicon
+		wantErr    bool
+	}{
+		{"embedded", Dictionaries{"spanish": "niños"}, "", EmbeddedLexicon(), false},
+		{"embedded by name", Dictionaries{"spanish": "niños"}, DefaultDictionary, EmbeddedLexicon(), false},
+		{"replaced default", Dictionaries{DefaultDictionary: "apple"}, "", NewLexicon("apple"), false},
+		{"named", Dictionaries{"spanish": "niños"}, "spanish", NewLexicon("niños"), false},
+		{"unknown", Dictionaries{"spanish": "niños"}, "klingon", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.Lexicon(test.dictionary)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("lexicons not equal")
+			}
+		})
+	}
+}
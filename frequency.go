@@ -0,0 +1,145 @@
+package words
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Frequencies are how often words are used, such as the number of times each word is in a large collection of text.
+// They are priors for how likely each word is to be the answer.  A nil Frequencies treats every word as equally likely.
+type Frequencies struct {
+	counts map[string]float64
+	// minCount is the weight of words that are not in the counts, so they are unlikely, but still possible
+	minCount float64
+}
+
+// Probability is the estimated chance that a word is the answer
+type Probability struct {
+	Word    string
+	Percent float64
+}
+
+// FrequenciesByLength are the frequencies of the words of each length
+type FrequenciesByLength map[int]*Frequencies
+
+// ParseFrequencies loads the frequencies of the words that are numLetters long.
+// Each line has a word and a positive count, separated by whitespace: "about 1226734006".
+// Blank lines are ignored.  An error is returned if any of the words are not lowercase.
+func ParseFrequencies(text string, numLetters int) (*Frequencies, error) {
+	if numLetters <= 0 {
+		return nil, fmt.Errorf("wanted positive word length, got %v", numLetters)
+	}
+	byLength, err := parseFrequencies(text, func(n int) bool { return n == numLetters })
+	if err != nil {
+		return nil, err
+	}
+	return byLength.Of(numLetters), nil
+}
+
+// ParseFrequenciesByLength loads the frequencies of the words of every length, reading the text once.
+// The lines are the same as the lines of ParseFrequencies.
+func ParseFrequenciesByLength(text string) (FrequenciesByLength, error) {
+	return parseFrequencies(text, func(int) bool { return true })
+}
+
+// parseFrequencies loads the frequencies of the words with the lengths to keep
+func parseFrequencies(text string, keep func(n int) bool) (FrequenciesByLength, error) {
+	byLength := make(FrequenciesByLength)
+	for i, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(fields) != 2:
+			return nil, fmt.Errorf("line %v: wanted a word and its count, got %q", i+1, line)
+		}
+		w := fields[0]
+		if w != strings.ToLower(w) {
+			return nil, fmt.Errorf("line %v: wanted word to be lowercase, got %q", i+1, w)
+		}
+		count, err := strconv.ParseFloat(fields[1], 64)
+		n := utf8.RuneCountInString(w)
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("line %v: parsing count: %w", i+1, err)
+		case !(count > 0):
+			return nil, fmt.Errorf("line %v: wanted positive count, got %v", i+1, fields[1])
+		case !keep(n):
+			continue
+		}
+		f, ok := byLength[n]
+		if !ok {
+			f = newFrequencies()
+			byLength[n] = f
+		}
+		if _, ok := f.counts[w]; ok {
+			return nil, fmt.Errorf("line %v: duplicate word: %q", i+1, w)
+		}
+		if len(f.counts) == 0 || count < f.minCount {
+			f.minCount = count
+		}
+		f.counts[w] = count
+	}
+	return byLength, nil
+}
+
+// newFrequencies creates frequencies without any words, which treat every word as equally likely
+func newFrequencies() *Frequencies {
+	f := Frequencies{
+		counts:   make(map[string]float64),
+		minCount: 1,
+	}
+	return &f
+}
+
+// Of is the frequencies of the words that are numLetters long.
+// The frequencies are empty if the text does not have any words of the length.
+func (byLength FrequenciesByLength) Of(numLetters int) *Frequencies {
+	if f, ok := byLength[numLetters]; ok {
+		return f
+	}
+	return newFrequencies()
+}
+
+// Weight is the prior weight of the word.
+// Words that do not have a frequency weigh as much as the least frequent word.
+func (f *Frequencies) Weight(w string) float64 {
+	if f == nil {
+		return 1
+	}
+	if count, ok := f.counts[w]; ok {
+		return count
+	}
+	return f.minCount
+}
+
+// Probabilities estimates the chance that each of the words is the answer from their weights.
+// The most likely words are first, with ties in alphabetical order.
+func (f *Frequencies) Probabilities(m Words) []Probability {
+	total := 0.0
+	probabilities := make([]Probability, 0, len(m))
+	for w := range m {
+		weight := f.Weight(w)
+		total += weight
+		probabilities = append(probabilities, Probability{Word: w, Percent: weight})
+	}
+	for i := range probabilities {
+		probabilities[i].Percent *= 100 / total
+	}
+	slices.SortFunc(probabilities, func(a, b Probability) int {
+		if a.Percent != b.Percent {
+			return cmp.Compare(b.Percent, a.Percent)
+		}
+		return cmp.Compare(a.Word, b.Word)
+	})
+	return probabilities
+}
+
+// String formats the word with its percentage: "apple (12.5%)"
+func (p Probability) String() string {
+	return fmt.Sprintf("%v (%.3g%%)", p.Word, p.Percent)
+}
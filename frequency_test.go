@@ -0,0 +1,155 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFrequencies(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		numLetters int
+		want       *Frequencies
+		wantErr    bool
+	}{
+		{
+			name: "empty",
+			want: &Frequencies{counts: map[string]float64{}, minCount: 1},
+		},
+		{
+			name: "counts",
+			text: "about 1226734006\nother 978481319\n\nthe 23135851162\nfjeld 2.5\n",
+			want: &Frequencies{
+				counts:   map[string]float64{"about": 1226734006, "other": 978481319, "fjeld": 2.5},
+				minCount: 2.5,
+			},
+		},
+		{
+			name:       "bad length",
+			text:       "apple 1",
+			numLetters: -1,
+			wantErr:    true,
+		},
+		{
+			name:    "missing count",
+			text:    "apple",
+			wantErr: true,
+		},
+		{
+			name:    "extra field",
+			text:    "apple 1 2",
+			wantErr: true,
+		},
+		{
+			name:    "uppercase",
+			text:    "Apple 1",
+			wantErr: true,
+		},
+		{
+			name:    "not a number",
+			text:    "apple many",
+			wantErr: true,
+		},
+		{
+			name:    "zero count",
+			text:    "apple 0",
+			wantErr: true,
+		},
+		{
+			name:    "not a number for short word",
+			text:    "the NaN",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate",
+			text:    "apple 1\napple 2",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			numLetters := test.numLetters
+			if numLetters == 0 {
+				numLetters = DefaultNumLetters
+			}
+			got, err := ParseFrequencies(test.text, numLetters)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %+v\ngot:    %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseFrequenciesByLength(t *testing.T) {
+	got, err := ParseFrequenciesByLength("the 5\napple 3\nberry 1\n")
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	tests := []struct {
+		numLetters int
+		want       *Frequencies
+	}{
+		{3, &Frequencies{counts: map[string]float64{"the": 5}, minCount: 5}},
+		{5, &Frequencies{counts: map[string]float64{"apple": 3, "berry": 1}, minCount: 1}},
+		{4, &Frequencies{counts: map[string]float64{}, minCount: 1}},
+	}
+	for _, test := range tests {
+		if want, got := test.want, got.Of(test.numLetters); !reflect.DeepEqual(want, got) {
+			t.Errorf("frequencies of words %v letters long not equal:\nwanted: %+v\ngot:    %+v", test.numLetters, want, got)
+		}
+	}
+	if _, err := ParseFrequenciesByLength("the 5\nApple 3"); err == nil {
+		t.Errorf("wanted error parsing uppercase word of any length")
+	}
+}
+
+func TestFrequenciesProbabilities(t *testing.T) {
+	m := Words{"apple": {}, "berry": {}, "cakes": {}, "dates": {}}
+	tests := []struct {
+		name string
+		*Frequencies
+		want []Probability
+	}{
+		{
+			name: "no frequencies",
+			want: []Probability{{"apple", 25}, {"berry", 25}, {"cakes", 25}, {"dates", 25}},
+		},
+		{
+			name:        "missing words are least frequent",
+			Frequencies: &Frequencies{counts: map[string]float64{"dates": 5, "berry": 2, "other": 1}, minCount: 1},
+			want:        []Probability{{"dates", 55.55555555555556}, {"berry", 22.22222222222222}, {"apple", 11.11111111111111}, {"cakes", 11.11111111111111}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.Frequencies.Probabilities(m)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestProbabilityString(t *testing.T) {
+	tests := []struct {
+		Probability
+		want string
+	}{
+		{Probability{"apple", 50}, "apple (50%)"},
+		{Probability{"berry", 12.3456}, "berry (12.3%)"},
+		{Probability{"cakes", 0.0012345}, "cakes (0.00123%)"},
+	}
+	for _, test := range tests {
+		if got := test.Probability.String(); test.want != got {
+			t.Errorf("wanted %q, got %q", test.want, got)
+		}
+	}
+}
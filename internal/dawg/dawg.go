@@ -0,0 +1,285 @@
+// Package dawg stores words in a directed acyclic word graph: a trie whose identical suffixes are shared.
+package dawg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+type (
+	// DAWG is a compact, sorted set of words that can be searched by prefix.
+	// It is never modified after it is created, so it can be shared between goroutines.
+	DAWG struct {
+		// nodes are the states of the graph.  The children of a node are before it, so the root is last.
+		nodes []node
+		// size is the number of words
+		size int
+	}
+	node struct {
+		final bool
+		edges []edge
+	}
+	edge struct {
+		letter rune
+		to     uint32
+	}
+	// builder adds sorted words to a DAWG, sharing each node with the same suffixes as a node that was already added
+	builder struct {
+		DAWG
+		// register finds the nodes that were added by their signatures
+		register map[string]uint32
+		// path are the unfinished nodes of the previous word, starting at the root
+		path []node
+		// prev is the previous word
+		prev []rune
+	}
+)
+
+// magic starts the binary form of a DAWG
+const magic = "DAWG1"
+
+// New creates a DAWG of the words
+func New(words []string) *DAWG {
+	sorted := slices.Clone(words)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	b := builder{
+		register: make(map[string]uint32),
+		path:     make([]node, 1),
+	}
+	for _, w := range sorted {
+		b.add([]rune(w))
+	}
+	b.finish(0)
+	root := b.path[0]
+	b.nodes = append(b.nodes, root)
+	return &b.DAWG
+}
+
+// add adds the word, which is sorted after the previous one
+func (b *builder) add(word []rune) {
+	n := 0
+	for n < len(word) && n < len(b.prev) && word[n] == b.prev[n] {
+		n++
+	}
+	b.finish(n)
+	for range word[n:] {
+		b.path = append(b.path, node{})
+	}
+	b.path[len(word)].final = true
+	b.prev = word
+	b.size++
+}
+
+// finish registers the nodes of the path after the first n letters of the previous word
+func (b *builder) finish(n int) {
+	for i := len(b.path) - 1; i > n; i-- {
+		to := b.id(b.path[i])
+		parent := &b.path[i-1]
+		parent.edges = append(parent.edges, edge{b.prev[i-1], to})
+	}
+	b.path = b.path[:n+1]
+}
+
+// id finds the index of an equal node, adding it if it is new
+func (b *builder) id(n node) uint32 {
+	key := n.signature()
+	if id, ok := b.register[key]; ok {
+		return id
+	}
+	id := uint32(len(b.nodes))
+	b.nodes = append(b.nodes, n)
+	b.register[key] = id
+	return id
+}
+
+// signature identifies the node by if it is final and by its edges
+func (n node) signature() string {
+	s := make([]byte, 0, 1+len(n.edges)*8)
+	if n.final {
+		s = append(s, 1)
+	} else {
+		s = append(s, 0)
+	}
+	for _, e := range n.edges {
+		s = binary.AppendUvarint(s, uint64(e.letter))
+		s = binary.AppendUvarint(s, uint64(e.to))
+	}
+	return string(s)
+}
+
+// Len is the number of words
+func (d *DAWG) Len() int {
+	return d.size
+}
+
+// root is the node that starts every word
+func (d *DAWG) root() node {
+	if len(d.nodes) == 0 {
+		return node{}
+	}
+	return d.nodes[len(d.nodes)-1]
+}
+
+// child is the node reached by the letter from the node, or false if the node has no edge for the letter
+func (d *DAWG) child(n node, letter rune) (node, bool) {
+	i, ok := slices.BinarySearchFunc(n.edges, letter, func(e edge, letter rune) int {
+		return int(e.letter - letter)
+	})
+	if !ok {
+		return node{}, false
+	}
+	return d.nodes[n.edges[i].to], true
+}
+
+// find is the node reached by the letters of the prefix
+func (d *DAWG) find(prefix string) (node, bool) {
+	n := d.root()
+	for _, ch := range prefix {
+		var ok bool
+		if n, ok = d.child(n, ch); !ok {
+			return node{}, false
+		}
+	}
+	return n, true
+}
+
+// Has determines if the word is in the DAWG
+func (d *DAWG) Has(word string) bool {
+	n, ok := d.find(word)
+	return ok && n.final
+}
+
+// HasPrefix determines if a word in the DAWG starts with the prefix
+func (d *DAWG) HasPrefix(prefix string) bool {
+	_, ok := d.find(prefix)
+	return ok
+}
+
+// Walk calls the function with each prefix of the words, in order.
+// The function is told if the prefix is a word and returns false to skip the longer words that start with the prefix.
+// The prefix is reused after the function returns, so it must be copied to be kept.
+func (d *DAWG) Walk(fn func(prefix []rune, isWord bool) bool) {
+	d.walk(d.root(), make([]rune, 0, 16), fn)
+}
+
+func (d *DAWG) walk(n node, prefix []rune, fn func(prefix []rune, isWord bool) bool) {
+	for _, e := range n.edges {
+		child := d.nodes[e.to]
+		p := append(prefix, e.letter)
+		if fn(p, child.final) {
+			d.walk(child, p, fn)
+		}
+	}
+}
+
+// Words lists the words, in order
+func (d *DAWG) Words() []string {
+	words := make([]string, 0, d.size)
+	d.Walk(func(prefix []rune, isWord bool) bool {
+		if isWord {
+			words = append(words, string(prefix))
+		}
+		return true
+	})
+	return words
+}
+
+// Text is the words, each on its own line
+func (d *DAWG) Text() string {
+	var sb strings.Builder
+	for _, w := range d.Words() {
+		sb.WriteString(w)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// MarshalBinary encodes the DAWG.  The edges of each node are stored by how far before the node their children are, which is usually small.
+func (d *DAWG) MarshalBinary() ([]byte, error) {
+	data := []byte(magic)
+	data = binary.AppendUvarint(data, uint64(d.size))
+	data = binary.AppendUvarint(data, uint64(len(d.nodes)))
+	for id, n := range d.nodes {
+		header := uint64(len(n.edges)) << 1
+		if n.final {
+			header |= 1
+		}
+		data = binary.AppendUvarint(data, header)
+		for _, e := range n.edges {
+			data = binary.AppendUvarint(data, uint64(e.letter))
+			data = binary.AppendUvarint(data, uint64(id)-uint64(e.to))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes the DAWG
+func (d *DAWG) UnmarshalBinary(data []byte) error {
+	r, ok := strings.CutPrefix(string(data), magic)
+	if !ok {
+		return fmt.Errorf("wanted dawg to start with %q", magic)
+	}
+	data = []byte(r)
+	var err error
+	read := func() uint64 {
+		if err != nil {
+			return 0
+		}
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			err = errors.New("unexpected end of dawg")
+			return 0
+		}
+		data = data[n:]
+		return v
+	}
+	size, count := read(), read()
+	switch {
+	case err != nil:
+		return err
+	case count == 0:
+		return errors.New("wanted dawg to have a root node")
+	case count > uint64(len(data)):
+		return fmt.Errorf("dawg is too short for %v nodes", count)
+	}
+	nodes := make([]node, count)
+	for id := range nodes {
+		header := read()
+		if header>>1 > uint64(len(data)) {
+			return fmt.Errorf("dawg is too short for the edges of node %v", id)
+		}
+		n := node{
+			final: header&1 == 1,
+			edges: make([]edge, header>>1),
+		}
+		for i := range n.edges {
+			letter, dist := read(), read()
+			switch {
+			case err != nil:
+				return err
+			case letter > utf8.MaxRune:
+				return fmt.Errorf("invalid letter in dawg: %v", letter)
+			case dist == 0 || dist > uint64(id):
+				return fmt.Errorf("node %v of dawg has an edge to a later node", id)
+			case i > 0 && rune(letter) <= n.edges[i-1].letter:
+				return fmt.Errorf("edges of node %v of dawg are not sorted", id)
+			}
+			n.edges[i] = edge{rune(letter), uint32(uint64(id) - dist)}
+		}
+		nodes[id] = n
+	}
+	switch {
+	case err != nil:
+		return err
+	case len(data) != 0:
+		return fmt.Errorf("dawg has %v extra bytes", len(data))
+	}
+	d.nodes = nodes
+	d.size = int(size)
+	return nil
+}
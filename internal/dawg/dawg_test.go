@@ -0,0 +1,132 @@
+package dawg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		words     []string
+		want      []string
+		wantNodes int
+	}{
+		{"empty", nil, []string{}, 1},
+		{"sorted", []string{"cat", "cats", "dog"}, []string{"cat", "cats", "dog"}, 7},
+		{"unsorted duplicates", []string{"dog", "cat", "dog"}, []string{"cat", "dog"}, 6},
+		{"shared suffixes", []string{"tap", "taps", "top", "tops"}, []string{"tap", "taps", "top", "tops"}, 5},
+		{"non-english", []string{"ñame", "niño", "año"}, []string{"año", "niño", "ñame"}, 8},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := New(test.words)
+			if got := d.Words(); !reflect.DeepEqual(test.want, got) {
+				t.Errorf("words not equal:\nwanted: %q\ngot:    %q", test.want, got)
+			}
+			if want, got := len(test.want), d.Len(); want != got {
+				t.Errorf("wanted %v words, got %v", want, got)
+			}
+			if want, got := test.wantNodes, len(d.nodes); want != got {
+				t.Errorf("wanted %v nodes, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestHas(t *testing.T) {
+	d := New([]string{"cat", "cats", "niño"})
+	tests := []struct {
+		word          string
+		wantHas       bool
+		wantHasPrefix bool
+	}{
+		{"", false, true},
+		{"c", false, true},
+		{"cat", true, true},
+		{"cats", true, true},
+		{"catsup", false, false},
+		{"ni", false, true},
+		{"niño", true, true},
+		{"nino", false, false},
+		{"dog", false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.word, func(t *testing.T) {
+			if want, got := test.wantHas, d.Has(test.word); want != got {
+				t.Errorf("has: wanted %v, got %v", want, got)
+			}
+			if want, got := test.wantHasPrefix, d.HasPrefix(test.word); want != got {
+				t.Errorf("has prefix: wanted %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestWalk(t *testing.T) {
+	d := New([]string{"bad", "cab", "cabbage", "dab", "dad", "ebb"})
+	var got []string
+	allowed := map[rune]bool{'a': true, 'b': true, 'c': true, 'd': true}
+	d.Walk(func(prefix []rune, isWord bool) bool {
+		if !allowed[prefix[len(prefix)-1]] {
+			return false
+		}
+		if isWord {
+			got = append(got, string(prefix))
+		}
+		return true
+	})
+	want := []string{"bad", "cab", "dab", "dad"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("not equal:\nwanted: %q\ngot:    %q", want, got)
+	}
+}
+
+func TestText(t *testing.T) {
+	d := New([]string{"dog", "cat"})
+	if want, got := "cat\ndog\n", d.Text(); want != got {
+		t.Errorf("wanted %q, got %q", want, got)
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	words := []string{"apple", "apples", "grape", "grapes", "größe", "ñame"}
+	data, err := New(words).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	var d DAWG
+	if err := d.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	if got := d.Words(); !reflect.DeepEqual(words, got) {
+		t.Errorf("words not equal:\nwanted: %q\ngot:    %q", words, got)
+	}
+	if want, got := len(words), d.Len(); want != got {
+		t.Errorf("wanted %v words, got %v", want, got)
+	}
+}
+
+func TestUnmarshalBinaryBad(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"no magic", "apple"},
+		{"no nodes", magic + "\x00\x00"},
+		{"too many nodes", magic + "\x00\x7f\x00"},
+		{"truncated", magic + "\x01\x02\x01"},
+		{"edge to later node", magic + "\x01\x02\x01\x02\x61\x00"},
+		{"unsorted edges", magic + "\x01\x02\x01\x04\x62\x01\x61\x01"},
+		{"extra bytes", magic + "\x00\x01\x00\x00"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var d DAWG
+			if err := d.UnmarshalBinary([]byte(test.data)); err == nil {
+				t.Errorf("wanted error")
+			}
+		})
+	}
+}
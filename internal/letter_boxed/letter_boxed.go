@@ -2,9 +2,8 @@ package letter_boxed
 
 import (
 	"fmt"
-	"slices"
-	"strings"
 
+	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
 )
 
@@ -13,6 +12,8 @@ type (
 		Letters       string
 		BoxSideCount  int
 		MinWordLength int
+		// Alphabet is the letters that can be on the box, English if nil
+		Alphabet *char_set.Alphabet
 	}
 	Result struct {
 		Words       []string
@@ -28,13 +29,17 @@ type (
 		targets     char_set.CharSet
 		words       []string
 		all         []connection
-		startsWith  [26][]*connection
-		endsWith    [26][]*connection
-		targetFreqs [26]int
+		startsWith  map[rune][]*connection
+		endsWith    map[rune][]*connection
+		targetFreqs map[rune]int
 	}
 )
 
 func (lb LetterBox) words(wordsText string) ([]string, error) {
+	return lb.lexiconWords(words.NewLexicon(wordsText))
+}
+
+func (lb LetterBox) lexiconWords(l *words.Lexicon) ([]string, error) {
 	letters := []rune(lb.Letters)
 	switch {
 	case len(letters) == 0:
@@ -45,8 +50,9 @@ func (lb LetterBox) words(wordsText string) ([]string, error) {
 		return nil, fmt.Errorf("wanted positive required word length: %v", lb.MinWordLength)
 	case len(letters)%lb.BoxSideCount != 0:
 		return nil, fmt.Errorf("letters on each side of box not equal")
+	case !lb.Alphabet.HasAll(lb.Letters):
+		return nil, fmt.Errorf("wanted letters in %q: %q", lb.Alphabet, lb.Letters)
 	}
-	words := strings.Fields(wordsText)
 	letterGroups := make([]string, lb.BoxSideCount)
 	k := len(letters) / lb.BoxSideCount
 	for i := range lb.BoxSideCount {
@@ -58,12 +64,15 @@ func (lb LetterBox) words(wordsText string) ([]string, error) {
 		return nil, err
 	}
 	var validWords []string
-	for _, word := range words {
-		if len(word) >= lb.MinWordLength && g.allows(word) {
-			validWords = append(validWords, word)
+	l.Walk(func(prefix []rune, isWord bool) bool {
+		if !g.allowsLast(prefix) {
+			return false
 		}
-	}
-	slices.Sort(validWords)
+		if isWord && len(prefix) >= lb.MinWordLength {
+			validWords = append(validWords, string(prefix))
+		}
+		return true
+	})
 	return validWords, nil
 }
 
@@ -80,28 +89,33 @@ func newGroups(letterGroups []string) (*groups, error) {
 	return &g, nil
 }
 
-func (g groups) allows(word string) bool {
-	var prevKey int
-	for i, r := range word {
-		currKey, ok := g[r]
-		switch {
-		case !ok,
-			i != 0 && prevKey == currKey:
-			return false
-		}
-		prevKey = currKey
+// allowsLast determines if the last letter of the prefix is on the box and on a different side than the letter before it
+func (g groups) allowsLast(prefix []rune) bool {
+	n := len(prefix)
+	currKey, ok := g[prefix[n-1]]
+	switch {
+	case !ok:
+		return false
+	case n == 1:
+		return true
 	}
-	return len(word) > 0
+	prevKey := g[prefix[n-2]]
+	return prevKey != currKey
 }
 
 func (lb LetterBox) Solve(wordsText string) (*Result, error) {
+	return lb.SolveLexicon(words.NewLexicon(wordsText))
+}
+
+// SolveLexicon finds the words of the lexicon that can be made on the box
+func (lb LetterBox) SolveLexicon(l *words.Lexicon) (*Result, error) {
 	// TODO: write solver, add timeout if algorithm is slow
-	words, err := lb.words(wordsText)
+	validWords, err := lb.lexiconWords(l)
 	if err != nil {
 		return nil, err
 	}
 	r := Result{
-		Words: words,
+		Words: validWords,
 	}
 	return &r, nil
 }
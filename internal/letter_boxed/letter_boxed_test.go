@@ -3,6 +3,8 @@ package letter_boxed
 import (
 	"slices"
 	"testing"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
 )
 
 func TestWords(t *testing.T) {
@@ -58,6 +60,17 @@ func TestWords(t *testing.T) {
 			wantOk:    true,
 			want:      []string{"dodo"},
 		},
+		{
+			name: "letters not in alphabet",
+			lb:   LetterBox{Letters: "äöü", BoxSideCount: 3, MinWordLength: 1},
+		},
+		{
+			name:      "german",
+			wordsText: "öl ölt löt tö",
+			lb:        LetterBox{Letters: "ölt", BoxSideCount: 3, MinWordLength: 2, Alphabet: char_set.German},
+			wantOk:    true,
+			want:      []string{"löt", "tö", "öl", "ölt"},
+		},
 		{
 			name:      "duplicate letters",
 			wordsText: "a aa aaa",
@@ -0,0 +1,172 @@
+// Package pattern_search finds the words of a dictionary that match crossword-style patterns.
+package pattern_search
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+)
+
+const (
+	// AnyLetter matches one letter of a pattern
+	AnyLetter = '?'
+	// AnyLetters matches any number of letters of a pattern, including none
+	AnyLetters = '*'
+)
+
+type (
+	// Query describes the words to search for.  Each of its fields that is empty matches every word of the alphabet.
+	Query struct {
+		// Pattern has the letters of the words, with '?' for any letter and '*' for any number of letters: "c?t*".
+		// The letters are fixed at their positions.  Every word matches the empty pattern.
+		Pattern string
+		// Required letters must be in the words
+		Required string
+		// Excluded letters must not be in the words
+		Excluded string
+		// MinLength is the fewest letters of the words
+		MinLength int
+		// MaxLength is the most letters of the words
+		MaxLength int
+		// Regexp is a regular expression that the words must match.  Anchor it with ^ and $ to match whole words.
+		Regexp string
+		// Alphabet is the letters of the words, English if nil
+		Alphabet *char_set.Alphabet
+	}
+	// matcher checks the prefixes of words with a compiled query
+	matcher struct {
+		Query
+		tokens   []rune
+		required []rune
+		re       *regexp.Regexp
+		// states are the positions of the pattern that can be reached after each letter of the prefix
+		states [][]bool
+	}
+)
+
+// Search finds the words of the lexicon that match the query, in order.
+// The words are walked by prefix, skipping the words that start with a prefix that can not match.
+func (q Query) Search(l *words.Lexicon) ([]string, error) {
+	m, err := q.newMatcher()
+	if err != nil {
+		return nil, err
+	}
+	var found []string
+	l.Walk(func(prefix []rune, isWord bool) bool {
+		if !m.allows(prefix) {
+			return false
+		}
+		if isWord && m.matches(prefix) {
+			found = append(found, string(prefix))
+		}
+		return q.MaxLength == 0 || len(prefix) < q.MaxLength
+	})
+	return found, nil
+}
+
+// newMatcher validates and compiles the query
+func (q Query) newMatcher() (*matcher, error) {
+	letters := strings.Map(func(r rune) rune {
+		if r == AnyLetter || r == AnyLetters {
+			return -1
+		}
+		return r
+	}, q.Pattern)
+	switch {
+	case !q.Alphabet.HasAll(letters):
+		return nil, fmt.Errorf("wanted pattern to be letters in %q or %q/%q: %q", q.Alphabet.Range(), AnyLetter, AnyLetters, q.Pattern)
+	case !q.Alphabet.HasAll(q.Required):
+		return nil, fmt.Errorf("wanted required letters in %q: %q", q.Alphabet.Range(), q.Required)
+	case !q.Alphabet.HasAll(q.Excluded):
+		return nil, fmt.Errorf("wanted excluded letters in %q: %q", q.Alphabet.Range(), q.Excluded)
+	case strings.ContainsAny(q.Required, q.Excluded):
+		return nil, fmt.Errorf("letters can not be required and excluded: %q, %q", q.Required, q.Excluded)
+	case strings.ContainsAny(letters, q.Excluded):
+		return nil, fmt.Errorf("letters of pattern can not be excluded: %q, %q", q.Pattern, q.Excluded)
+	case q.MinLength < 0, q.MaxLength < 0:
+		return nil, fmt.Errorf("wanted lengths to not be negative: %v-%v", q.MinLength, q.MaxLength)
+	case q.MaxLength != 0 && q.MinLength > q.MaxLength:
+		return nil, fmt.Errorf("wanted min length to not be more than max length: %v-%v", q.MinLength, q.MaxLength)
+	}
+	m := matcher{
+		Query:    q,
+		tokens:   []rune(q.Pattern),
+		required: []rune(q.Required),
+	}
+	if len(q.Regexp) != 0 {
+		re, err := regexp.Compile(q.Regexp)
+		if err != nil {
+			return nil, fmt.Errorf("parsing regular expression: %w", err)
+		}
+		m.re = re
+	}
+	start := make([]bool, len(m.tokens)+1)
+	start[0] = true
+	m.states = [][]bool{m.closure(start)}
+	return &m, nil
+}
+
+// allows determines if words that start with the prefix could match, by checking its last letter.
+// The prefixes must be walked in order, so the states of the earlier letters are still known.
+func (m *matcher) allows(prefix []rune) bool {
+	n := len(prefix)
+	ch := prefix[n-1]
+	if !m.Alphabet.Has(ch) || strings.ContainsRune(m.Excluded, ch) {
+		return false
+	}
+	if len(m.Pattern) == 0 {
+		return true
+	}
+	m.states = m.states[:n]
+	next := m.step(m.states[n-1], ch)
+	m.states = append(m.states, next)
+	return slices.Contains(next, true)
+}
+
+// step finds the positions of the pattern that can be reached by the letter from the positions
+func (m *matcher) step(positions []bool, ch rune) []bool {
+	next := make([]bool, len(positions))
+	for i, ok := range positions[:len(m.tokens)] {
+		if !ok {
+			continue
+		}
+		switch t := m.tokens[i]; t {
+		case AnyLetters:
+			next[i] = true
+		case AnyLetter, ch:
+			next[i+1] = true
+		}
+	}
+	return m.closure(next)
+}
+
+// closure adds the positions after each '*' that can be reached, because it can match no letters
+func (m *matcher) closure(positions []bool) []bool {
+	for i, t := range m.tokens {
+		if positions[i] && t == AnyLetters {
+			positions[i+1] = true
+		}
+	}
+	return positions
+}
+
+// matches determines if the word, which was allowed by its prefixes, matches the query
+func (m *matcher) matches(word []rune) bool {
+	n := len(word)
+	switch {
+	case n < m.MinLength,
+		m.MaxLength != 0 && n > m.MaxLength,
+		len(m.Pattern) != 0 && !m.states[n][len(m.tokens)]:
+		return false
+	}
+	for _, ch := range m.required {
+		if !slices.Contains(word, ch) {
+			return false
+		}
+	}
+	return m.re == nil || m.re.MatchString(string(word))
+}
@@ -0,0 +1,134 @@
+package pattern_search
+
+import (
+	"slices"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+)
+
+func TestQuerySearch(t *testing.T) {
+	l := words.NewLexicon("at cat cats coat cot cut scat act tact niño ñame")
+	tests := []struct {
+		name string
+		Query
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: []string{"act", "at", "cat", "cats", "coat", "cot", "cut", "scat", "tact"},
+		},
+		{
+			name:  "fixed letters",
+			Query: Query{Pattern: "c?t"},
+			want:  []string{"cat", "cot", "cut"},
+		},
+		{
+			name:  "any letters",
+			Query: Query{Pattern: "c?t*"},
+			want:  []string{"cat", "cats", "cot", "cut"},
+		},
+		{
+			name:  "any letters between",
+			Query: Query{Pattern: "c*t"},
+			want:  []string{"cat", "coat", "cot", "cut"},
+		},
+		{
+			name:  "any letters at start",
+			Query: Query{Pattern: "*at"},
+			want:  []string{"at", "cat", "coat", "scat"},
+		},
+		{
+			name:  "repeated any letters",
+			Query: Query{Pattern: "**a**"},
+			want:  []string{"act", "at", "cat", "cats", "coat", "scat", "tact"},
+		},
+		{
+			name:  "required letters",
+			Query: Query{Required: "sa"},
+			want:  []string{"cats", "scat"},
+		},
+		{
+			name:  "excluded letters",
+			Query: Query{Pattern: "*t", Excluded: "o"},
+			want:  []string{"act", "at", "cat", "cut", "scat", "tact"},
+		},
+		{
+			name:  "lengths",
+			Query: Query{MinLength: 3, MaxLength: 3},
+			want:  []string{"act", "cat", "cot", "cut"},
+		},
+		{
+			name:  "regular expression",
+			Query: Query{Regexp: "^t.*t$"},
+			want:  []string{"tact"},
+		},
+		{
+			name:  "non-english",
+			Query: Query{Pattern: "????", Required: "ñ", Alphabet: char_set.Spanish},
+			want:  []string{"niño", "ñame"},
+		},
+		{
+			name:  "non-english fixed letters",
+			Query: Query{Pattern: "ñ*", Alphabet: char_set.Spanish},
+			want:  []string{"ñame"},
+		},
+		{
+			name:    "letter not in alphabet",
+			Query:   Query{Pattern: "ñ*"},
+			wantErr: true,
+		},
+		{
+			name:    "required letter not in alphabet",
+			Query:   Query{Required: "Q"},
+			wantErr: true,
+		},
+		{
+			name:    "excluded letter not in alphabet",
+			Query:   Query{Excluded: "!"},
+			wantErr: true,
+		},
+		{
+			name:    "required and excluded",
+			Query:   Query{Required: "ab", Excluded: "bc"},
+			wantErr: true,
+		},
+		{
+			name:    "pattern letter excluded",
+			Query:   Query{Pattern: "c?t", Excluded: "c"},
+			wantErr: true,
+		},
+		{
+			name:    "negative length",
+			Query:   Query{MinLength: -1},
+			wantErr: true,
+		},
+		{
+			name:    "min length more than max",
+			Query:   Query{MinLength: 5, MaxLength: 4},
+			wantErr: true,
+		},
+		{
+			name:    "bad regular expression",
+			Query:   Query{Regexp: "(c"},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.Query.Search(l)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !slices.Equal(test.want, got):
+				t.Errorf("not equal:\nwanted: %q\ngot:    %q", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/absurdle"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+)
+
+// AbsurdleHost is a game where the guesses are scored to keep the most answers possible.
+// The scores are not in the query because the host always gives the same scores to the same guesses.
+type AbsurdleHost struct {
+	WordLength   int
+	Alphabet     *char_set.Alphabet
+	Results      result.Results
+	Remaining    int
+	ShowPossible bool
+	Possible     []string
+	Done         bool
+}
+
+func NewAbsurdleHost(query map[string][]string, wt WordsText) (*AbsurdleHost, error) {
+	for k, v := range query {
+		if len(v) != 1 {
+			return nil, fmt.Errorf("wanted only one value for %q", k)
+		}
+	}
+
+	numLetters, err := parseWordLength(query)
+	if err != nil {
+		return nil, err
+	}
+	alphabet, err := parseAlphabet(query)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := wt.newLists(numLetters)
+	if err != nil {
+		return nil, fmt.Errorf("creating word lists: %w", err)
+	}
+	h := absurdle.New(lists.Answers, numLetters, alphabet)
+
+	for i := 0; ; i++ {
+		guessKey := fmt.Sprintf("g%v", i)
+		gI, ok := query[guessKey]
+		if !ok || len(gI[0]) == 0 {
+			break
+		}
+		g := guess.New(gI[0])
+		if err := g.Validate(lists.Guesses, numLetters); err != nil {
+			return nil, fmt.Errorf("reading guess %v: %w", i+1, err)
+		}
+		if _, err := h.Score(g); err != nil {
+			return nil, fmt.Errorf("scoring guess %v: %w", i+1, err)
+		}
+	}
+
+	ah := AbsurdleHost{
+		WordLength: numLetters,
+		Alphabet:   alphabet,
+		Results:    h.Results(),
+		Remaining:  len(h.Possible),
+		Done:       h.Solved(),
+	}
+	if _, ok := query["ShowPossible"]; ok {
+		ah.ShowPossible = true
+	}
+	if ah.ShowPossible && !ah.Done {
+		ah.Possible = make([]string, 0, len(h.Possible))
+		for w := range h.Possible {
+			ah.Possible = append(ah.Possible, w)
+		}
+		slices.Sort(ah.Possible)
+	}
+	return &ah, nil
+}
+
+// Tiles creates a row of tiles for each scored guess
+func (ah AbsurdleHost) Tiles() [][]Tile {
+	tiles := make([][]Tile, len(ah.Results))
+	for i, r := range ah.Results {
+		letters := []rune(string(r.Guess))
+		tiles[i] = make([]Tile, len(letters))
+		for j, ch := range letters {
+			tiles[i][j] = Tile{
+				Letter: string(ch),
+				Score:  string(r.Score[j : j+1]),
+			}
+		}
+	}
+	return tiles
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+)
+
+func TestNewAbsurdleHost(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  map[string][]string
+		wantOk bool
+		want   AbsurdleHost
+	}{
+		{
+			name:   "empty",
+			wantOk: true,
+			want: AbsurdleHost{
+				WordLength: 5,
+				Remaining:  4,
+			},
+		},
+		{
+			name: "possible words",
+			query: map[string][]string{
+				"g0":           {"forts"},
+				"g1":           {"forth"},
+				"ShowPossible": {""},
+			},
+			wantOk: true,
+			want: AbsurdleHost{
+				WordLength: 5,
+				Results: result.Results{
+					{Guess: "forts", Score: "ccccn"},
+					{Guess: "forth", Score: "ccccn"},
+				},
+				Remaining:    2,
+				ShowPossible: true,
+				Possible:     []string{"forte", "forty"},
+			},
+		},
+		{
+			name: "solved",
+			query: map[string][]string{
+				"g0":           {"forts"},
+				"g1":           {"forth"},
+				"g2":           {"forty"},
+				"g3":           {"forte"},
+				"g5":           {"forte"}, // ignored after a missing guess
+				"ShowPossible": {""},
+			},
+			wantOk: true,
+			want: AbsurdleHost{
+				WordLength: 5,
+				Results: result.Results{
+					{Guess: "forts", Score: "ccccn"},
+					{Guess: "forth", Score: "ccccn"},
+					{Guess: "forty", Score: "ccccn"},
+					{Guess: "forte", Score: "ccccc"},
+				},
+				Remaining:    1,
+				ShowPossible: true,
+				Done:         true,
+			},
+		},
+		{
+			name: "guess after solved",
+			query: map[string][]string{
+				"g0": {"forts"},
+				"g1": {"forth"},
+				"g2": {"forty"},
+				"g3": {"forte"},
+				"g4": {"forte"},
+			},
+		},
+		{
+			name: "short guess",
+			query: map[string][]string{
+				"g0": {"fort"},
+			},
+		},
+		{
+			name: "guess not a word",
+			query: map[string][]string{
+				"g0": {"forto"},
+			},
+		},
+		{
+			name: "bad word length",
+			query: map[string][]string{
+				"WordLength": {"-1"},
+			},
+		},
+		{
+			name: "bad alphabet",
+			query: map[string][]string{
+				"Alphabet": {"klingon"},
+			},
+		},
+		{
+			name: "duplicate guesses",
+			query: map[string][]string{
+				"g0": {"forts", "forth"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			words := "forte forth forts forty"
+			got, err := NewAbsurdleHost(test.query, WordsText{Words: words})
+			switch {
+			case !test.wantOk:
+				if err == nil {
+					t.Error("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case got == nil, !reflect.DeepEqual(test.want, *got):
+				t.Errorf("unequal: \n wanted: %+v \n got:    %+v", test.want, *got)
+			}
+		})
+	}
+}
+
+func TestNewAbsurdleHostNoAnswers(t *testing.T) {
+	if _, err := NewAbsurdleHost(map[string][]string{"WordLength": {"6"}, "g0": {"fortes"}}, WordsText{Words: "forte"}); err == nil {
+		t.Errorf("wanted error hosting a game without answers")
+	}
+}
+
+func TestAbsurdleHostTiles(t *testing.T) {
+	ah := AbsurdleHost{
+		Results: result.Results{
+			{Guess: "forts", Score: "ccanc"},
+		},
+	}
+	want := [][]Tile{
+		{
+			{Letter: "f", Score: "c"},
+			{Letter: "o", Score: "c"},
+			{Letter: "r", Score: "a"},
+			{Letter: "t", Score: "n"},
+			{Letter: "s", Score: "c"},
+		},
+	}
+	if got := ah.Tiles(); !reflect.DeepEqual(want, got) {
+		t.Errorf("not equal:\nwanted: %v\ngot:    %v", want, got)
+	}
+}
@@ -6,44 +6,226 @@ import (
 	"fmt"
 	"html/template"
 	"net/http"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
 )
 
-//go:embed main.html main.css wordle.html spelling_bee.html letter_boxed.html instructions.html
+//go:embed main.html main.css wordle.html multi_board.html spelling_bee.html letter_boxed.html pattern_search.html absurdle.html instructions.html alphabet.html dictionary.html
 var _siteFS embed.FS
 
 const (
-	wordlePath      = "/"
-	spellingBeePath = "/spelling-bee"
-	letterBoxedPath = "/letter-boxed"
+	wordlePath        = "/"
+	multiBoardPath    = "/multi-board"
+	spellingBeePath   = "/spelling-bee"
+	letterBoxedPath   = "/letter-boxed"
+	patternSearchPath = "/pattern-search"
+	absurdlePath      = "/absurdle"
 )
 
-func NewHandler(wordsText string) http.Handler {
+// WordsText is the text of the word lists that the cheaters load
+type WordsText struct {
+	// Words are all the words that can be used
+	Words string
+	// WordsLexicon is the parsed words, such as the embedded words.  It is used instead of the text of the words if it is set.
+	WordsLexicon *words.Lexicon
+	// Dictionaries are word lists that can be used instead of the words, picked by name with the Dictionary query parameter
+	Dictionaries words.Dictionaries
+	// Dictionary is the name of the picked dictionary, empty if the words are used
+	Dictionary string
+	// Answers are the words that are likely wordle answers.  All the words can be answers if it is empty.
+	Answers string
+	// Frequencies has a word and how often it is used on each line, used to rank the possible wordle answers
+	Frequencies string
+	// PastAnswers has the date and answer of an earlier puzzle on each line, used to rule out answers that were already used
+	PastAnswers string
+	// Tree has the wordle guesses to make after each score, suggested for the words of its length.  It is only used with the words.
+	Tree *decision_tree.Tree
+	// lexicons are the parsed word lists, nil if the text is parsed for each request
+	lexicons *lexicons
+}
+
+// lexicons are the word lists, parsed once when the handler is created and shared by every request
+type lexicons struct {
+	words   *words.Lexicon
+	answers *words.Lexicon
+	// lists are the guesses and answers of each word length.  They are shared, so they must not be modified.
+	lists map[int]*words.Lists
+	// frequencies are the parsed frequencies of the words of each length
+	frequencies words.FrequenciesByLength
+	pastAnswers words.PastAnswers
+	// dictionaries are the parsed word lists of the named dictionaries, without any answers
+	dictionaries map[string]*lexicons
+}
+
+// index parses the word lists so the requests can share them instead of parsing them each time.
+// An error is returned if the frequencies or past answers can not be parsed.
+func (wt WordsText) index() (*WordsText, error) {
+	l := newLexicons(wt.wordsLexicon(), words.NewLexicon(wt.Answers))
+	if len(wt.Frequencies) != 0 {
+		f, err := words.ParseFrequenciesByLength(wt.Frequencies)
+		if err != nil {
+			return nil, fmt.Errorf("parsing word frequencies: %w", err)
+		}
+		l.frequencies = f
+	}
+	if len(wt.PastAnswers) != 0 {
+		pa, err := words.ParsePastAnswers(wt.PastAnswers)
+		if err != nil {
+			return nil, fmt.Errorf("parsing past answers: %w", err)
+		}
+		l.pastAnswers = pa
+	}
+	l.dictionaries = make(map[string]*lexicons, len(wt.Dictionaries))
+	noAnswers := words.NewLexicon("")
+	for name, text := range wt.Dictionaries {
+		if !words.IsDefaultDictionary(name) {
+			l.dictionaries[name] = newLexicons(words.NewLexicon(text), noAnswers)
+		}
+	}
+	wt.lexicons = l
+	return &wt, nil
+}
+
+// newLexicons creates the lists of the words and answers of every length that a wordle can have
+func newLexicons(wordsLexicon, answers *words.Lexicon) *lexicons {
+	l := lexicons{
+		words:   wordsLexicon,
+		answers: answers,
+		lists:   make(map[int]*words.Lists, maxWordLength),
+	}
+	for n := 1; n <= maxWordLength; n++ {
+		if lists, err := wordsLexicon.Lists(answers, n); err == nil {
+			l.lists[n] = lists
+		}
+	}
+	return &l
+}
+
+// wordsLexicon is the parsed words
+func (wt WordsText) wordsLexicon() *words.Lexicon {
+	switch {
+	case wt.lexicons != nil:
+		return wt.lexicons.words
+	case wt.WordsLexicon != nil:
+		return wt.WordsLexicon
+	}
+	return words.NewLexicon(wt.Words)
+}
+
+// answersLexicon is the parsed answers
+func (wt WordsText) answersLexicon() *words.Lexicon {
+	if wt.lexicons == nil {
+		return words.NewLexicon(wt.Answers)
+	}
+	return wt.lexicons.answers
+}
+
+// newLists creates the guesses and answers that are numLetters long.
+// The lists may be shared with other requests, so they must be copied before they are modified.
+func (wt WordsText) newLists(numLetters int) (*words.Lists, error) {
+	if wt.lexicons != nil {
+		if lists, ok := wt.lexicons.lists[numLetters]; ok {
+			return lists, nil
+		}
+	}
+	return wt.wordsLexicon().Lists(wt.answersLexicon(), numLetters)
+}
+
+// priors are the frequencies of the words that are numLetters long, nil if there are no frequencies
+func (wt WordsText) priors(numLetters int) (*words.Frequencies, error) {
+	switch {
+	case len(wt.Frequencies) == 0:
+		return nil, nil
+	case wt.lexicons == nil:
+		return words.ParseFrequencies(wt.Frequencies, numLetters)
+	case numLetters <= 0:
+		return nil, fmt.Errorf("wanted positive word length, got %v", numLetters)
+	}
+	return wt.lexicons.frequencies.Of(numLetters), nil
+}
+
+// parsedPastAnswers are the past answers, nil if there are none
+func (wt WordsText) parsedPastAnswers() (words.PastAnswers, error) {
+	switch {
+	case len(wt.PastAnswers) == 0:
+		return nil, nil
+	case wt.lexicons == nil:
+		return words.ParsePastAnswers(wt.PastAnswers)
+	}
+	return wt.lexicons.pastAnswers, nil
+}
+
+const dictionaryParam = "Dictionary"
+
+// withDictionary uses the words of the dictionary named by the query, if it names one other than the default.
+// The answers, frequencies and past answers are only used with the words, which they describe.
+func (wt WordsText) withDictionary(query map[string][]string) (WordsText, error) {
+	v := query[dictionaryParam]
+	if len(v) == 0 || words.IsDefaultDictionary(v[0]) {
+		return wt, nil
+	}
+	text, err := wt.Dictionaries.Text(v[0])
+	if err != nil {
+		return WordsText{}, fmt.Errorf("parsing %q: %w", dictionaryParam, err)
+	}
+	picked := WordsText{
+		Words:        text,
+		Dictionaries: wt.Dictionaries,
+		Dictionary:   v[0],
+	}
+	if wt.lexicons != nil {
+		picked.lexicons = wt.lexicons.dictionaries[v[0]]
+	}
+	return picked, nil
+}
+
+// NewHandler creates the handler of the pages, which share the word lists parsed when it is created.
+// An error is returned if the frequencies or past answers can not be parsed.
+func NewHandler(wt WordsText) (http.Handler, error) {
+	indexed, err := wt.index()
+	if err != nil {
+		return nil, err
+	}
+	wt = *indexed
 	inc := func(i int) int {
 		return i + 1
 	}
 	arr := func(s ...string) []string {
 		return s
 	}
+	scorePattern := func(n int) string {
+		return fmt.Sprintf("(%v){%v}", score.Pattern(), n)
+	}
 	funcs := template.FuncMap{
-		"inc": inc,
-		"arr": arr,
+		"inc":          inc,
+		"arr":          arr,
+		"scorePattern": scorePattern,
+		"alphabets":    char_set.Alphabets,
+		"strategies":   func() []recommend.Strategy { return recommend.Strategies },
 	}
 	tmpl := template.Must(newTemplate().
 	Funcs(funcs).
 	ParseFS(_siteFS, "*.html", "*.css"))
 	
 	mux := http.NewServeMux()
-	mux.HandleFunc("GET "+wordlePath+"{$}", handle(wordlePage, wordsText, tmpl))
-	mux.HandleFunc("GET "+spellingBeePath, handle(spellingBeePage, wordsText, tmpl))
-	mux.HandleFunc("GET "+letterBoxedPath, handle(letterBoxedPage, wordsText, tmpl))
+	mux.HandleFunc("GET "+wordlePath+"{$}", handle(wordlePage, wt, tmpl))
+	mux.HandleFunc("GET "+multiBoardPath, handle(multiBoardPage, wt, tmpl))
+	mux.HandleFunc("GET "+spellingBeePath, handle(spellingBeePage, wt, tmpl))
+	mux.HandleFunc("GET "+letterBoxedPath, handle(letterBoxedPage, wt, tmpl))
+	mux.HandleFunc("GET "+patternSearchPath, handle(patternSearchPage, wt, tmpl))
+	mux.HandleFunc("GET "+absurdlePath, handle(absurdlePage, wt, tmpl))
 
-	return withContentEncoding(mux)
+	return withContentEncoding(mux), nil
 }
 
-func handle(p page, wordsText string, tmpl *template.Template) http.HandlerFunc {
+func handle(p page, wt WordsText, tmpl *template.Template) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		q := r.URL.Query()
-		d, err := p.newDisplay(q, wordsText)
+		d, err := p.newDisplay(q, wt)
 		if err != nil {
 			handleBadRequest(w, "creating cheater", err)
 			return
@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"html/template"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"strings"
 	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
 )
 
 func TestNewHandler(t *testing.T) {
@@ -34,6 +38,21 @@ func TestNewHandler(t *testing.T) {
 			target:   wordlePath + "?g0=word&s0=c",
 			wantCode: 400,
 		},
+		{
+			name:     "multi-board-empty",
+			target:   multiBoardPath,
+			wantCode: 200,
+		},
+		{
+			name:     "multi-board-ok",
+			target:   multiBoardPath + "?Boards=2&g0=words&s0-0=ccccc&s0-1=nnnnn",
+			wantCode: 200,
+		},
+		{
+			name:     "multi-board-bad",
+			target:   multiBoardPath + "?Boards=2&g0=words&s0-0=ccccc",
+			wantCode: 400,
+		},
 		{
 			name:     "spelling-bee-empty",
 			target:   spellingBeePath,
@@ -69,11 +88,44 @@ func TestNewHandler(t *testing.T) {
 			target:   letterBoxedPath + "?" + letterBoxedLettersParam + "=hello",
 			wantCode: 400,
 		},
+		{
+			name:     "pattern-search-empty",
+			target:   patternSearchPath,
+			wantCode: 200,
+		},
+		{
+			name:     "pattern-search-ok",
+			target:   patternSearchPath + "?" + patternParam + "=c%3Ft*&" + minLengthParam + "=3",
+			wantCode: 200,
+		},
+		{
+			name:     "pattern-search-bad-regexp",
+			target:   patternSearchPath + "?" + regexpParam + "=(c",
+			wantCode: 400,
+		},
+		{
+			name:     "absurdle-empty",
+			target:   absurdlePath,
+			wantCode: 200,
+		},
+		{
+			name:     "absurdle-ok",
+			target:   absurdlePath + "?WordLength=6&ShowPossible",
+			wantCode: 200,
+		},
+		{
+			name:     "absurdle-bad",
+			target:   absurdlePath + "?g0=word",
+			wantCode: 400,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			var wordsText string
-			h := NewHandler(wordsText)
+			h, err := NewHandler(WordsText{Words: wordsText})
+			if err != nil {
+				t.Fatalf("unwanted error: %v", err)
+			}
 			w := httptest.NewRecorder()
 			r := httptest.NewRequest("GET", test.target, nil)
 			h.ServeHTTP(w, r)
@@ -84,6 +136,121 @@ func TestNewHandler(t *testing.T) {
 	}
 }
 
+func TestNewHandlerBadWordsText(t *testing.T) {
+	tests := []struct {
+		name string
+		WordsText
+	}{
+		{"frequencies", WordsText{Frequencies: "apple often"}},
+		{"past answers", WordsText{PastAnswers: "yesterday apple"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewHandler(test.WordsText); err == nil {
+				t.Errorf("wanted error")
+			}
+		})
+	}
+}
+
+func TestWordsTextWithDictionary(t *testing.T) {
+	wt := WordsText{
+		Words:        "apple berry",
+		Answers:      "apple",
+		Dictionaries: words.Dictionaries{words.DefaultDictionary: "apple berry", "spanish": "niños señor"},
+	}
+	tests := []struct {
+		name    string
+		query   map[string][]string
+		want    WordsText
+		wantErr bool
+	}{
+		{
+			name: "no dictionary",
+			want: wt,
+		},
+		{
+			name:  "default",
+			query: map[string][]string{dictionaryParam: {words.DefaultDictionary}},
+			want:  wt,
+		},
+		{
+			name:  "spanish",
+			query: map[string][]string{dictionaryParam: {"spanish"}},
+			want: WordsText{
+				Words:        "niños señor",
+				Dictionaries: wt.Dictionaries,
+				Dictionary:   "spanish",
+			},
+		},
+		{
+			name:    "unknown",
+			query:   map[string][]string{dictionaryParam: {"klingon"}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := wt.withDictionary(test.query)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %+v\ngot:    %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNewHandlerDictionary(t *testing.T) {
+	wt := WordsText{
+		Words:        "apple",
+		Dictionaries: words.Dictionaries{words.DefaultDictionary: "apple", "spanish": "niños señor"},
+	}
+	h, err := NewHandler(wt)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", wordlePath+"?Dictionary=spanish&Alphabet=spanish&ShowPossible", nil)
+	h.ServeHTTP(w, r)
+	body := w.Body.String()
+	switch {
+	case w.Code != 200:
+		t.Errorf("wanted ok status, got %v: %q", w.Code, body)
+	case !strings.Contains(body, `<option value="spanish" selected>spanish</option>`):
+		t.Errorf("wanted spanish dictionary to be selected: %q", body)
+	case !strings.Contains(body, ">niños señor </textarea>"):
+		t.Errorf("wanted words of spanish dictionary to be possible: %q", body)
+	}
+}
+
+func TestNewHandlerDefaultDictionary(t *testing.T) {
+	wt := WordsText{
+		Words:        "apple berry",
+		Answers:      "apple",
+		Dictionaries: words.Dictionaries{words.DefaultDictionary: "apple berry", "spanish": "niños señor"},
+	}
+	h, err := NewHandler(wt)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", wordlePath+"?Dictionary=default&ShowPossible", nil)
+	h.ServeHTTP(w, r)
+	body := w.Body.String()
+	switch {
+	case w.Code != 200:
+		t.Errorf("wanted ok status, got %v: %q", w.Code, body)
+	case !strings.Contains(body, ">apple </textarea>"):
+		t.Errorf("wanted only the answers of the default dictionary to be possible: %q", body)
+	}
+}
+
 func TestHandleBadRequest(t *testing.T) {
 	message := "my-message"
 	err := fmt.Errorf("my-error")
@@ -154,7 +321,10 @@ func TestHandleGzip(t *testing.T) {
 	w := httptest.NewRecorder()
 	r := httptest.NewRequest("GET", "/", nil)
 	r.Header.Set("Accept-Encoding", "gzip")
-	h := NewHandler("")
+	h, err := NewHandler(WordsText{})
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
 
 	h.ServeHTTP(w, r)
 
@@ -162,3 +332,40 @@ func TestHandleGzip(t *testing.T) {
 		t.Errorf("got: %q", enc)
 	}
 }
+
+// BenchmarkPageRequest compares parsing the words for each request to sharing the index.
+// With the shared index, the wordle pages still copy the answers of the word length to filter them for each request.
+func BenchmarkPageRequest(b *testing.B) {
+	pages := []struct {
+		page
+		query string
+	}{
+		{wordlePage, "g0=soare&s0=cnanc&ShowPossible"},
+		{multiBoardPage, "Boards=4&g0=soare&s0-0=cnanc&s0-1=nnnna&s0-2=nnncn&s0-3=nnnac&ShowPossible"},
+		{spellingBeePage, "central-letter=a&other-letters=bcdefg"},
+		{letterBoxedPage, "letters=abcdefghijkl"},
+	}
+	wt := WordsText{Words: words.EmbeddedText()}
+	indexes := []struct {
+		name string
+		WordsText
+	}{
+		{"parsed per request", wt},
+		{"shared index", mustIndex(b, wt)},
+	}
+	for _, p := range pages {
+		query, err := url.ParseQuery(p.query)
+		if err != nil {
+			b.Fatalf("parsing query: %v", err)
+		}
+		for _, index := range indexes {
+			b.Run(p.tmplName+"/"+index.name, func(b *testing.B) {
+				for b.Loop() {
+					if _, err := p.newDisplay(query, index.WordsText); err != nil {
+						b.Fatalf("creating display: %v", err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"cmp"
 	"fmt"
 	"slices"
+	"unicode/utf8"
 
+	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/letter_boxed"
 )
 
@@ -19,12 +21,12 @@ const (
 	letterBoxedLettersParam = "letters"
 )
 
-func NewLetterBoxedCheater(query map[string][]string, wordsText string) (*LetterBoxedCheater, error) {
+func NewLetterBoxedCheater(query map[string][]string, l *words.Lexicon) (*LetterBoxedCheater, error) {
 	lb, err := newLetterBox(query)
 	if err != nil {
 		return nil, fmt.Errorf("parsing params: %w", err)
 	}
-	r, err := lb.Solve(wordsText)
+	r, err := lb.SolveLexicon(l)
 	if err != nil {
 		return nil, fmt.Errorf("searching for words: %v", err)
 	}
@@ -37,9 +39,14 @@ func NewLetterBoxedCheater(query map[string][]string, wordsText string) (*Letter
 }
 
 func newLetterBox(query map[string][]string) (*letter_boxed.LetterBox, error) {
+	alphabet, err := parseAlphabet(query)
+	if err != nil {
+		return nil, err
+	}
 	lb := letter_boxed.LetterBox{
 		BoxSideCount:  4,
 		MinWordLength: 3,
+		Alphabet:      alphabet,
 	}
 	letters := query[letterBoxedLettersParam]
 	switch n := len(letters); {
@@ -52,8 +59,8 @@ func newLetterBox(query map[string][]string) (*letter_boxed.LetterBox, error) {
 }
 
 func (LetterBoxedCheater) sortWords(a, b string) int {
-	if len(a) != len(b) {
-		return len(b) - len(a)
+	if n, m := utf8.RuneCountInString(a), utf8.RuneCountInString(b); n != m {
+		return m - n
 	}
 	return cmp.Compare(a, b)
 }
@@ -4,6 +4,7 @@ import (
 	"slices"
 	"testing"
 
+	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/letter_boxed"
 )
 
@@ -30,6 +31,29 @@ func TestNewLetterBoxedCheater(t *testing.T) {
 			query:   map[string][]string{letterBoxedParam: {"eokmpjuarlcb", "eokmpjuarlcb"}},
 			wantErr: true,
 		},
+		{
+			name:    "unknown alphabet",
+			query:   map[string][]string{letterBoxedParam: {"eokmpjuarlcb"}, alphabetParam: {"klingon"}},
+			wantErr: true,
+		},
+		{
+			name:    "letters not in alphabet",
+			query:   map[string][]string{letterBoxedParam: {"eokmpjuarlcñ"}},
+			wantErr: true,
+		},
+		{
+			name:      "spanish",
+			query:     map[string][]string{letterBoxedParam: {"eokmpjuarlcñ"}, alphabetParam: {"spanish"}},
+			wordsText: "ñame jock queen",
+			want: LetterBoxedCheater{
+				LetterBox: letter_boxed.LetterBox{
+					Letters: "eokmpjuarlcñ",
+				},
+				Result: letter_boxed.Result{
+					Words: []string{"jock", "ñame"},
+				},
+			},
+		},
 		{
 			name:      "ok",
 			query:     map[string][]string{letterBoxedParam: {"eokmpjuarlcb"}},
@@ -46,7 +70,7 @@ func TestNewLetterBoxedCheater(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := NewLetterBoxedCheater(test.query, test.wordsText)
+			got, err := NewLetterBoxedCheater(test.query, words.NewLexicon(test.wordsText))
 			switch {
 			case got == nil, err != nil:
 				if !test.wantErr {
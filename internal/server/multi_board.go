@@ -0,0 +1,207 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/multi_board"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+type (
+	MultiBoardCheater struct {
+		WordLength      int
+		Alphabet        *char_set.Alphabet
+		BoardCount      int
+		Rows            []MultiBoardRow
+		Boards          []MultiBoard
+		ShowPossible    bool
+		Suggestions     []recommend.Recommendation
+		ShowSuggestions bool
+		SuggestFromAll  bool
+		Done            bool
+	}
+	// MultiBoardRow is a guess and its score on each board
+	MultiBoardRow struct {
+		Guess  guess.Guess
+		Scores []MultiBoardScore
+	}
+	// MultiBoardScore is the score of a guess on a board.  It is not active if the board was already solved.
+	MultiBoardScore struct {
+		Score  score.Score
+		Active bool
+	}
+	// MultiBoard is the state of a board after all the guesses
+	MultiBoard struct {
+		Possible []string
+		Solved   bool
+	}
+)
+
+const (
+	boardCountParam   = "Boards"
+	defaultBoardCount = 4
+	maxBoardCount     = 32
+)
+
+func NewMultiBoardCheater(query map[string][]string, wt WordsText) (*MultiBoardCheater, error) {
+	for k, v := range query {
+		if len(v) != 1 {
+			return nil, fmt.Errorf("wanted only one value for %q", k)
+		}
+	}
+
+	numLetters, err := parseWordLength(query)
+	if err != nil {
+		return nil, err
+	}
+	boardCount, err := parseBoardCount(query)
+	if err != nil {
+		return nil, err
+	}
+	alphabet, err := parseAlphabet(query)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := wt.newLists(numLetters)
+	if err != nil {
+		return nil, fmt.Errorf("creating word lists: %w", err)
+	}
+
+	mbc, err := newMultiBoardCheater(query, *lists, numLetters, boardCount, alphabet)
+	if err != nil {
+		return nil, fmt.Errorf("parsing query: %w", err)
+	}
+	return mbc, nil
+}
+
+func parseBoardCount(query map[string][]string) (int, error) {
+	v, ok := query[boardCountParam]
+	if !ok || len(v[0]) == 0 {
+		return defaultBoardCount, nil
+	}
+	n, err := strconv.Atoi(v[0])
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("parsing %q: %w", boardCountParam, err)
+	case n <= 0, n > maxBoardCount:
+		return 0, fmt.Errorf("%q must be between 1 and %v", boardCountParam, maxBoardCount)
+	}
+	return n, nil
+}
+
+func newMultiBoardCheater(query map[string][]string, lists words.Lists, numLetters, boardCount int, alphabet *char_set.Alphabet) (*MultiBoardCheater, error) {
+	mbc := MultiBoardCheater{
+		WordLength: numLetters,
+		Alphabet:   alphabet,
+		BoardCount: boardCount,
+	}
+	bs, err := multi_board.New(boardCount, lists.Answers, alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	maxGuesses := multi_board.MaxGuesses(boardCount)
+	for i := 0; i < maxGuesses && !bs.Solved(); i++ {
+		row, err := parseMultiBoardRow(query, i, numLetters, bs)
+		switch {
+		case err != nil:
+			return nil, err
+		case row != nil:
+			var scores []score.Score
+			for _, s := range row.Scores {
+				if s.Active {
+					scores = append(scores, s.Score)
+				}
+			}
+			if err := bs.AddGuess(row.Guess, scores); err != nil {
+				return nil, err
+			}
+			mbc.Rows = append(mbc.Rows, *row)
+		}
+	}
+
+	if _, ok := query["ShowPossible"]; ok {
+		mbc.ShowPossible = true
+	}
+	if _, ok := query["ShowSuggestions"]; ok {
+		mbc.ShowSuggestions = true
+	}
+	if _, ok := query["SuggestFromAll"]; ok {
+		mbc.SuggestFromAll = true
+	}
+
+	mbc.Done = bs.Solved() || len(mbc.Rows) >= maxGuesses
+	if !mbc.Done {
+		row := MultiBoardRow{
+			Scores: make([]MultiBoardScore, boardCount),
+		}
+		for _, j := range bs.Unsolved() {
+			row.Scores[j].Active = true
+		}
+		mbc.Rows = append(mbc.Rows, row)
+	}
+
+	mbc.Boards = make([]MultiBoard, boardCount)
+	for i, b := range bs {
+		mbc.Boards[i].Solved = b.Solved()
+		if mbc.ShowPossible && !b.Solved() {
+			mbc.Boards[i].Possible = make([]string, 0, len(b.Possible))
+			for k := range b.Possible {
+				mbc.Boards[i].Possible = append(mbc.Boards[i].Possible, k)
+			}
+			slices.Sort(mbc.Boards[i].Possible)
+		}
+	}
+
+	if mbc.ShowSuggestions && !mbc.Done {
+		r := recommend.Recommender{
+			FromAll:   mbc.SuggestFromAll,
+			Count:     suggestionCount,
+			MaxScores: suggestionMaxScores,
+		}
+		mbc.Suggestions = r.RankBoards(bs.Possibles(), lists.Guesses)
+	}
+
+	return &mbc, nil
+}
+
+func parseMultiBoardRow(query map[string][]string, i, numLetters int, bs multi_board.Boards) (*MultiBoardRow, error) {
+	guessKey := fmt.Sprintf("g%v", i)
+	gI, ok := query[guessKey]
+	if !ok || len(gI[0]) == 0 {
+		return nil, nil
+	}
+	g := guess.New(gI[0])
+	var anyWord words.Words
+	if err := g.Validate(anyWord, numLetters); err != nil {
+		return nil, fmt.Errorf("reading guess %v: %w", i+1, err)
+	}
+
+	row := MultiBoardRow{
+		Guess:  g,
+		Scores: make([]MultiBoardScore, len(bs)),
+	}
+	for _, j := range bs.Unsolved() {
+		scoreKey := fmt.Sprintf("s%v-%v", i, j)
+		sI, ok := query[scoreKey]
+		if !ok {
+			return nil, fmt.Errorf("missing score %v for board %v", i+1, j+1)
+		}
+		s := score.New(sI[0])
+		if err := s.Validate(numLetters); err != nil {
+			return nil, fmt.Errorf("reading score %v for board %v: %w", i+1, j+1, err)
+		}
+		row.Scores[j] = MultiBoardScore{
+			Score:  s,
+			Active: true,
+		}
+	}
+	return &row, nil
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
+)
+
+func TestNewMultiBoardCheater(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  map[string][]string
+		wantOk bool
+		want   MultiBoardCheater
+	}{
+		{
+			name:   "empty",
+			wantOk: true,
+			want: MultiBoardCheater{
+				WordLength: 5,
+				BoardCount: 4,
+				Rows: []MultiBoardRow{
+					{Scores: []MultiBoardScore{{Active: true}, {Active: true}, {Active: true}, {Active: true}}},
+				},
+				Boards: make([]MultiBoard, 4),
+			},
+		},
+		{
+			name: "one board solved",
+			query: map[string][]string{
+				"Boards":          {"2"},
+				"g0":              {"forts"},
+				"s0-0":            {"ccccc"},
+				"s0-1":            {"ccccn"},
+				"ShowPossible":    {""},
+				"ShowSuggestions": {""},
+			},
+			wantOk: true,
+			want: MultiBoardCheater{
+				WordLength: 5,
+				BoardCount: 2,
+				Rows: []MultiBoardRow{
+					{Guess: "forts", Scores: []MultiBoardScore{{Score: "ccccc", Active: true}, {Score: "ccccn", Active: true}}},
+					{Scores: []MultiBoardScore{{}, {Active: true}}},
+				},
+				Boards: []MultiBoard{
+					{Solved: true},
+					{Possible: []string{"forte", "forth", "forty"}},
+				},
+				ShowPossible: true,
+				Suggestions: []recommend.Recommendation{
+					{Guess: "forte", Entropy: 0.9182958340544896, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forth", Entropy: 0.9182958340544896, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forty", Entropy: 0.9182958340544896, Largest: 2, Outcomes: 2, Possible: true},
+				},
+				ShowSuggestions: true,
+			},
+		},
+		{
+			name: "all boards solved",
+			query: map[string][]string{
+				"Boards": {"2"},
+				"g0":     {"forts"},
+				"s0-0":   {"ccccc"},
+				"s0-1":   {"ccccn"},
+				"g1":     {"forty"},
+				"s1-1":   {"ccccc"},
+			},
+			wantOk: true,
+			want: MultiBoardCheater{
+				WordLength: 5,
+				BoardCount: 2,
+				Rows: []MultiBoardRow{
+					{Guess: "forts", Scores: []MultiBoardScore{{Score: "ccccc", Active: true}, {Score: "ccccn", Active: true}}},
+					{Guess: "forty", Scores: []MultiBoardScore{{}, {Score: "ccccc", Active: true}}},
+				},
+				Boards: []MultiBoard{
+					{Solved: true},
+					{Solved: true},
+				},
+				Done: true,
+			},
+		},
+		{
+			name: "out of guesses",
+			query: map[string][]string{
+				"Boards": {"1"},
+				"g0":     {"forte"}, "s0-0": {"ccccn"},
+				"g1": {"forte"}, "s1-0": {"ccccn"},
+				"g2": {"forte"}, "s2-0": {"ccccn"},
+				"g3": {"forte"}, "s3-0": {"ccccn"},
+				"g4": {"forte"}, "s4-0": {"ccccn"},
+				"g5": {"forte"}, "s5-0": {"ccccn"},
+				"g6": {"forte"}, "s6-0": {"ccccn"}, // ignored
+			},
+			wantOk: true,
+			want: MultiBoardCheater{
+				WordLength: 5,
+				BoardCount: 1,
+				Rows: []MultiBoardRow{
+					{Guess: "forte", Scores: []MultiBoardScore{{Score: "ccccn", Active: true}}},
+					{Guess: "forte", Scores: []MultiBoardScore{{Score: "ccccn", Active: true}}},
+					{Guess: "forte", Scores: []MultiBoardScore{{Score: "ccccn", Active: true}}},
+					{Guess: "forte", Scores: []MultiBoardScore{{Score: "ccccn", Active: true}}},
+					{Guess: "forte", Scores: []MultiBoardScore{{Score: "ccccn", Active: true}}},
+					{Guess: "forte", Scores: []MultiBoardScore{{Score: "ccccn", Active: true}}},
+				},
+				Boards: make([]MultiBoard, 1),
+				Done:   true,
+			},
+		},
+		{
+			name: "missing score",
+			query: map[string][]string{
+				"Boards": {"2"},
+				"g0":     {"forts"},
+				"s0-0":   {"ccccc"},
+			},
+		},
+		{
+			name: "invalid score",
+			query: map[string][]string{
+				"Boards": {"1"},
+				"g0":     {"forts"},
+				"s0-0":   {"cc"},
+			},
+		},
+		{
+			name: "invalid guess",
+			query: map[string][]string{
+				"Boards": {"1"},
+				"g0":     {"fort"},
+				"s0-0":   {"ccccc"},
+			},
+		},
+		{
+			name: "bad board count",
+			query: map[string][]string{
+				"Boards": {"0"},
+			},
+		},
+		{
+			name: "board count not a number",
+			query: map[string][]string{
+				"Boards": {"four"},
+			},
+		},
+		{
+			name: "bad word length",
+			query: map[string][]string{
+				"WordLength": {"-1"},
+			},
+		},
+		{
+			name: "duplicate guesses",
+			query: map[string][]string{
+				"g0": {"forts", "forth"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			words := "forte forth forts forty"
+			got, err := NewMultiBoardCheater(test.query, WordsText{Words: words})
+			switch {
+			case !test.wantOk:
+				if err == nil {
+					t.Error("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case got == nil, !reflect.DeepEqual(test.want, *got):
+				t.Errorf("unequal: \n wanted: %+v \n got:    %+v", test.want, *got)
+			}
+		})
+	}
+}
+
+func TestNewMultiBoardCheaterBadWordsText(t *testing.T) {
+	if _, err := NewMultiBoardCheater(map[string][]string{}, WordsText{Words: "Words"}); err == nil {
+		t.Errorf("wanted error running with capitalized word")
+	}
+	if _, err := NewMultiBoardCheater(map[string][]string{}, WordsText{Words: "words", Answers: "Words"}); err == nil {
+		t.Errorf("wanted error running with capitalized answer")
+	}
+}
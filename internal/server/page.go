@@ -1,15 +1,28 @@
 package server
 
+import (
+	"cmp"
+	"fmt"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+)
+
 type (
 	display struct {
 		page
 		NoJS    bool
 		Cheater any
+		// Dictionaries are the names of the word lists that can be picked
+		Dictionaries []string
+		// Dictionary is the name of the picked word list
+		Dictionary string
 	}
 	page struct {
 		Title      string
 		tmplName   string
-		newCheater func(query map[string][]string, wordsText string) (any, error)
+		newCheater func(query map[string][]string, wt WordsText) (any, error)
 	}
 )
 
@@ -19,21 +32,36 @@ var (
 		tmplName:   "wordle.html",
 		newCheater: wrapCheater(NewWordleCheater),
 	}
+	multiBoardPage = page{
+		Title:      "Multi-Board Wordle Cheater",
+		tmplName:   "multi_board.html",
+		newCheater: wrapCheater(NewMultiBoardCheater),
+	}
 	spellingBeePage = page{
 		Title:      "Spelling Bee Cheater",
 		tmplName:   "spelling_bee.html",
-		newCheater: wrapCheater(NewSpellingBeeCheater),
+		newCheater: wrapCheater(onlyWords(NewSpellingBeeCheater)),
 	}
 	letterBoxedPage = page{
 		Title:      "Letter Boxed Cheater",
 		tmplName:   "letter_boxed.html",
-		newCheater: wrapCheater(NewLetterBoxedCheater),
+		newCheater: wrapCheater(onlyWords(NewLetterBoxedCheater)),
+	}
+	patternSearchPage = page{
+		Title:      "Pattern Search Cheater",
+		tmplName:   "pattern_search.html",
+		newCheater: wrapCheater(onlyWords(NewPatternSearchCheater)),
+	}
+	absurdlePage = page{
+		Title:      "Absurdle Host",
+		tmplName:   "absurdle.html",
+		newCheater: wrapCheater(NewAbsurdleHost),
 	}
 )
 
-func wrapCheater[T any](f func(query map[string][]string, wordsText string) (T, error)) func(query map[string][]string, wordsText string) (any, error) {
-	return func(query map[string][]string, wordsText string) (any, error) {
-		c, err := f(query, wordsText)
+func wrapCheater[T any](f func(query map[string][]string, wt WordsText) (T, error)) func(query map[string][]string, wt WordsText) (any, error) {
+	return func(query map[string][]string, wt WordsText) (any, error) {
+		c, err := f(query, wt)
 		if err != nil {
 			return nil, err
 		}
@@ -41,14 +69,41 @@ func wrapCheater[T any](f func(query map[string][]string, wordsText string) (T,
 	}
 }
 
-func (p page) newDisplay(query map[string][]string, wordsText string) (*display, error) {
-	c, err := p.newCheater(query, wordsText)
+func onlyWords[T any](f func(query map[string][]string, l *words.Lexicon) (T, error)) func(query map[string][]string, wt WordsText) (T, error) {
+	return func(query map[string][]string, wt WordsText) (T, error) {
+		return f(query, wt.wordsLexicon())
+	}
+}
+
+const alphabetParam = "Alphabet"
+
+// parseAlphabet finds the alphabet named by the query.  It is nil, which is English, if the query does not name one.
+func parseAlphabet(query map[string][]string) (*char_set.Alphabet, error) {
+	v := query[alphabetParam]
+	if len(v) == 0 || len(v[0]) == 0 {
+		return nil, nil
+	}
+	a, err := char_set.AlphabetNamed(v[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", alphabetParam, err)
+	}
+	return a, nil
+}
+
+func (p page) newDisplay(query map[string][]string, wt WordsText) (*display, error) {
+	wt, err := wt.withDictionary(query)
+	if err != nil {
+		return nil, err
+	}
+	c, err := p.newCheater(query, wt)
 	if err != nil {
 		return nil, err
 	}
 	d := display{
-		page:    p,
-		Cheater: c,
+		page:         p,
+		Cheater:      c,
+		Dictionaries: wt.Dictionaries.Names(),
+		Dictionary:   cmp.Or(wt.Dictionary, words.DefaultDictionary),
 	}
 	return &d, nil
 }
@@ -57,6 +112,10 @@ func (p page) IsWordle() bool {
 	return p.Title == wordlePage.Title
 }
 
+func (p page) IsMultiBoard() bool {
+	return p.Title == multiBoardPage.Title
+}
+
 func (p page) IsSpellingBee() bool {
 	return p.Title == spellingBeePage.Title
 }
@@ -64,3 +123,11 @@ func (p page) IsSpellingBee() bool {
 func (p page) IsLetterBoxed() bool {
 	return p.Title == letterBoxedPage.Title
 }
+
+func (p page) IsPatternSearch() bool {
+	return p.Title == patternSearchPage.Title
+}
+
+func (p page) IsAbsurdle() bool {
+	return p.Title == absurdlePage.Title
+}
@@ -5,8 +5,11 @@ import "testing"
 func TestUniquePageTitles(t *testing.T) {
 	titles := []string{
 		wordlePage.Title,
+		multiBoardPage.Title,
 		spellingBeePage.Title,
 		letterBoxedPage.Title,
+		patternSearchPage.Title,
+		absurdlePage.Title,
 	}
 	m := make(map[string]struct{}, len(titles))
 	for _, title := range titles {
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/pattern_search"
+)
+
+type (
+	PatternSearchCheater struct {
+		pattern_search.Query
+		// Searched is true if the query had a search
+		Searched bool
+		// Words are the first words that were found
+		Words []string
+		// Total is the number of words that were found, which can be more than the words that are shown
+		Total int
+	}
+)
+
+const (
+	patternParam   = "Pattern"
+	requiredParam  = "Required"
+	excludedParam  = "Excluded"
+	minLengthParam = "MinLength"
+	maxLengthParam = "MaxLength"
+	regexpParam    = "Regexp"
+	// maxPatternSearchWords is the most words that are shown, because some patterns match most of the dictionary
+	maxPatternSearchWords = 1000
+)
+
+func NewPatternSearchCheater(query map[string][]string, l *words.Lexicon) (*PatternSearchCheater, error) {
+	q, err := newPatternSearchQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("parsing params: %w", err)
+	}
+	psc := PatternSearchCheater{
+		Query: *q,
+	}
+	for _, param := range []string{patternParam, requiredParam, excludedParam, minLengthParam, maxLengthParam, regexpParam} {
+		if v := query[param]; len(v) != 0 && len(v[0]) != 0 {
+			psc.Searched = true
+		}
+	}
+	if !psc.Searched {
+		return &psc, nil
+	}
+	found, err := q.Search(l)
+	if err != nil {
+		return nil, fmt.Errorf("searching for words: %w", err)
+	}
+	psc.Total = len(found)
+	psc.Words = found[:min(len(found), maxPatternSearchWords)]
+	return &psc, nil
+}
+
+func newPatternSearchQuery(query map[string][]string) (*pattern_search.Query, error) {
+	alphabet, err1 := parseAlphabet(query)
+	minLength, err2 := parseLength(query, minLengthParam)
+	maxLength, err3 := parseLength(query, maxLengthParam)
+	if err := cmp.Or(err1, err2, err3); err != nil {
+		return nil, err
+	}
+	q := pattern_search.Query{
+		Pattern:   firstParam(query, patternParam),
+		Required:  firstParam(query, requiredParam),
+		Excluded:  firstParam(query, excludedParam),
+		MinLength: minLength,
+		MaxLength: maxLength,
+		Regexp:    firstParam(query, regexpParam),
+		Alphabet:  alphabet,
+	}
+	return &q, nil
+}
+
+// firstParam is the first value of the param, or empty if the query does not have it
+func firstParam(query map[string][]string, paramName string) string {
+	if v := query[paramName]; len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// parseLength parses the word length of the param, which is zero if the query does not have it
+func parseLength(query map[string][]string, paramName string) (int, error) {
+	v := firstParam(query, paramName)
+	if len(v) == 0 {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("parsing %q: %w", paramName, err)
+	case n < 0:
+		return 0, fmt.Errorf("%q must not be negative", paramName)
+	}
+	return n, nil
+}
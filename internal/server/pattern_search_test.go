@@ -0,0 +1,113 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/pattern_search"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+)
+
+func TestNewPatternSearchCheater(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     map[string][]string
+		wordsText string
+		wantErr   bool
+		want      PatternSearchCheater
+	}{
+		{
+			name:      "no search",
+			wordsText: "cat cot",
+		},
+		{
+			name: "all params",
+			query: map[string][]string{
+				patternParam:   {"c*t"},
+				requiredParam:  {"o"},
+				excludedParam:  {"s"},
+				minLengthParam: {"3"},
+				maxLengthParam: {"4"},
+				regexpParam:    {"a"},
+			},
+			wordsText: "cat coat cot cost colt",
+			want: PatternSearchCheater{
+				Query: pattern_search.Query{
+					Pattern:   "c*t",
+					Required:  "o",
+					Excluded:  "s",
+					MinLength: 3,
+					MaxLength: 4,
+					Regexp:    "a",
+				},
+				Searched: true,
+				Words:    []string{"coat"},
+				Total:    1,
+			},
+		},
+		{
+			name:      "spanish",
+			query:     map[string][]string{patternParam: {"ñ*"}, alphabetParam: {"spanish"}},
+			wordsText: "ñame niño",
+			want: PatternSearchCheater{
+				Query:    pattern_search.Query{Pattern: "ñ*", Alphabet: char_set.Spanish},
+				Searched: true,
+				Words:    []string{"ñame"},
+				Total:    1,
+			},
+		},
+		{
+			name:    "bad length",
+			query:   map[string][]string{minLengthParam: {"three"}},
+			wantErr: true,
+		},
+		{
+			name:    "negative length",
+			query:   map[string][]string{maxLengthParam: {"-3"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown alphabet",
+			query:   map[string][]string{alphabetParam: {"klingon"}},
+			wantErr: true,
+		},
+		{
+			name:    "bad pattern",
+			query:   map[string][]string{patternParam: {"C?T"}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewPatternSearchCheater(test.query, words.NewLexicon(test.wordsText))
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, *got):
+				t.Errorf("not equal:\nwanted: %+v\ngot:    %+v", test.want, *got)
+			}
+		})
+	}
+}
+
+func TestNewPatternSearchCheaterMaxWords(t *testing.T) {
+	var wordsText []byte
+	for i := range maxPatternSearchWords + 5 {
+		wordsText = append(wordsText, 'a'+byte(i/26/26%26), 'a'+byte(i/26%26), 'a'+byte(i%26), ' ')
+	}
+	query := map[string][]string{patternParam: {"???"}}
+	got, err := NewPatternSearchCheater(query, words.NewLexicon(string(wordsText)))
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case maxPatternSearchWords != len(got.Words):
+		t.Errorf("wanted %v words shown, got %v", maxPatternSearchWords, len(got.Words))
+	case maxPatternSearchWords+5 != got.Total:
+		t.Errorf("wanted %v words found, got %v", maxPatternSearchWords+5, got.Total)
+	}
+}
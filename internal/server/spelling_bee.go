@@ -4,7 +4,9 @@ import (
 	"cmp"
 	"fmt"
 	"slices"
+	"unicode/utf8"
 
+	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/spelling_bee"
 )
 
@@ -27,19 +29,20 @@ const (
 	otherLettersParam  = "other-letters"
 )
 
-func NewSpellingBeeCheater(query map[string][]string, wordsText string) (*SpellingBeeCheater, error) {
+func NewSpellingBeeCheater(query map[string][]string, l *words.Lexicon) (*SpellingBeeCheater, error) {
 	sb, err := newSpellingBee(query)
 	if err != nil {
 		return nil, err
 	}
-	sbc := newSpellingBeeCheater(*sb, wordsText)
+	sbc := newSpellingBeeCheater(*sb, l)
 	return sbc, nil
 }
 
 func newSpellingBee(query map[string][]string) (*spelling_bee.SpellingBee, error) {
 	centralLetters, err1 := parseParam(centralLetterParam, 1, query)
 	otherLetters, err2 := parseParam(otherLettersParam, 6, query)
-	if err := cmp.Or(err1, err2); err != nil {
+	alphabet, err3 := parseAlphabet(query)
+	if err := cmp.Or(err1, err2, err3); err != nil {
 		return nil, err
 	}
 	if (len(centralLetters) == 0) != (len(otherLetters) == 0) {
@@ -48,6 +51,7 @@ func newSpellingBee(query map[string][]string) (*spelling_bee.SpellingBee, error
 	sb := spelling_bee.SpellingBee{
 		OtherLetters: otherLetters,
 		MinLength:    4,
+		Alphabet:     alphabet,
 	}
 	for _, r := range centralLetters {
 		sb.CentralLetter = r
@@ -62,19 +66,19 @@ func parseParam(paramName string, wantLength int, query map[string][]string) (st
 		return "", nil
 	case len(value) != 1:
 		return "", fmt.Errorf("only one %q parameter allowed", paramName)
-	case len(value[0]) != wantLength:
+	case utf8.RuneCountInString(value[0]) != wantLength:
 		return "", fmt.Errorf("%q must be %v characters long", paramName, wantLength)
 	}
 	return value[0], nil
 }
 
-func newSpellingBeeCheater(sb spelling_bee.SpellingBee, wordsText string) *SpellingBeeCheater {
+func newSpellingBeeCheater(sb spelling_bee.SpellingBee, l *words.Lexicon) *SpellingBeeCheater {
 	sbc := SpellingBeeCheater{
 		SpellingBee: sb,
 	}
-	words := sb.Words(wordsText)
-	sbc.Words = make([]Word, len(words))
-	for i, w := range words {
+	found := sb.LexiconWords(l)
+	sbc.Words = make([]Word, len(found))
+	for i, w := range found {
 		sbc.Words[i].Value = w.Value
 		sbc.Words[i].Score = w.Score
 		sbc.TotalScore += w.Score
@@ -4,7 +4,9 @@ import (
 	"reflect"
 	"testing"
 
+	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/spelling_bee"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
 )
 
 func TestNewSpellingBee(t *testing.T) {
@@ -34,6 +36,29 @@ func TestNewSpellingBee(t *testing.T) {
 				MinLength:     4,
 			},
 		},
+		{
+			name: "spanish",
+			query: map[string][]string{
+				centralLetterParam: {"ñ"},
+				otherLettersParam:  {"bcdefg"},
+				alphabetParam:      {"spanish"},
+			},
+			wantOk: true,
+			want: spelling_bee.SpellingBee{
+				CentralLetter: 'ñ',
+				OtherLetters:  "bcdefg",
+				MinLength:     4,
+				Alphabet:      char_set.Spanish,
+			},
+		},
+		{
+			name: "unknown alphabet",
+			query: map[string][]string{
+				centralLetterParam: {"a"},
+				otherLettersParam:  {"bcdefg"},
+				alphabetParam:      {"klingon"},
+			},
+		},
 		{
 			name: "missing central-letter",
 			query: map[string][]string{
@@ -111,7 +136,7 @@ func TestNewSpellingBeeCheater(t *testing.T) {
 			{Score: 1, Value: "am"},
 		},
 	}
-	got := newSpellingBeeCheater(sb, wordsText)
+	got := newSpellingBeeCheater(sb, words.NewLexicon(wordsText))
 	if !reflect.DeepEqual(want, *got) {
 		t.Errorf("not equal: \n wanted: %v \n    got: %v", want, *got)
 	}
@@ -1,79 +1,509 @@
 package server
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"net/url"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/letter_stats"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
 )
 
 type WordleCheater struct {
-	Results      []result.Result
-	Possible     []string
-	ShowPossible bool
-	Done         bool
+	WordLength      int
+	Alphabet        *char_set.Alphabet
+	Dictionary      string
+	Constraints     result.Constraints
+	Results         []result.Result
+	Possible        []string
+	Probabilities   []words.Probability
+	ShowPossible    bool
+	Stats           *letter_stats.Stats
+	ShowStats       bool
+	Suggestions     []recommend.Recommendation
+	TreeGuess       guess.Guess
+	OffTree         string
+	ShowSuggestions bool
+	SuggestFromAll  bool
+	SuggestStrategy recommend.Strategy
+	HardMode        bool
+	Contradiction   *result.ContradictionError
+	WhyNot          string
+	WhyNotReasons   []string
+	Date            string
+	PastAnswers     int
+	Share           string
+	SharePaths      []SharePath
+	Done            bool
 }
 
-func NewWordleCheater(query map[string][]string, wordsText string) (*WordleCheater, error) {
+// Tile is a letter of a guess and its score.
+// The link opens the page with the score of the letter changed to the next kind of score: not, almost, then correct.
+type Tile struct {
+	Letter string
+	Score  string
+	Link   string
+}
+
+// wordleWords are the word lists that a wordle cheater uses, with other data about the words
+type wordleWords struct {
+	words.Lists
+	alphabet    *char_set.Alphabet
+	priors      *words.Frequencies
+	pastAnswers words.PastAnswers
+	// date is the day of the puzzle, the past answers before it are not possible
+	date time.Time
+	// tree has the guesses to suggest after each score, nil if they are searched for
+	tree *decision_tree.Tree
+}
+
+// SharePath is the guesses that could have been made for a row of a shared grid
+type SharePath struct {
+	Score   score.Score
+	Count   int
+	Guesses []string
+}
+
+const (
+	suggestionCount = 10
+	maxPathGuesses  = 10
+	wordLengthParam = "WordLength"
+	shareCodeParam  = "Code"
+	dateParam       = "Date"
+	correctParam    = "Correct"
+	almostParam     = "Almost"
+	strategyParam   = "SuggestStrategy"
+	maxWordLength   = 15
+)
+
+// suggestionMaxScores limits the work of ranking the suggested guesses for a request.
+// Only a sample of the guesses is ranked when the guesses times the possible words is more.
+const suggestionMaxScores = 1_000_000
+
+func NewWordleCheater(query map[string][]string, wt WordsText) (*WordleCheater, error) {
 	for k, v := range query {
 		if len(v) != 1 {
 			return nil, fmt.Errorf("wanted only one value for %q", k)
 		}
 	}
 
-	m, err := words.New(wordsText)
+	shared, err := parseShareCode(query)
+	if err != nil {
+		return nil, err
+	}
+
+	numLetters, err := parseWordLength(query)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := query[wordLengthParam]; !ok && len(shared) != 0 {
+		numLetters = len(shared[0].Guess)
+	}
+
+	alphabet, err := parseAlphabet(query)
 	if err != nil {
-		return nil, fmt.Errorf("creating word list: %w", err)
+		return nil, err
 	}
 
-	wc, err := newWordleCheater(query, *m)
+	lists, err := wt.newLists(numLetters)
+	if err != nil {
+		return nil, fmt.Errorf("creating word lists: %w", err)
+	}
+	ww := wordleWords{
+		Lists:    *lists,
+		alphabet: alphabet,
+	}
+	if ww.priors, err = wt.priors(numLetters); err != nil {
+		return nil, fmt.Errorf("creating word frequencies: %w", err)
+	}
+	if wt.Tree != nil && wt.Tree.NumLetters() == numLetters {
+		ww.tree = wt.Tree
+	}
+	if ww.pastAnswers, err = wt.parsedPastAnswers(); err != nil {
+		return nil, fmt.Errorf("creating past answers: %w", err)
+	}
+	if ww.pastAnswers != nil {
+		if ww.date, err = parseDate(query, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	wc, err := newWordleCheater(query, ww, numLetters, shared)
 	if err != nil {
 		return nil, fmt.Errorf("parsing query: %w", err)
 	}
+	wc.Dictionary = wt.Dictionary
 	return wc, nil
 }
 
-func newWordleCheater(query map[string][]string, m words.Words) (*WordleCheater, error) {
+func parseWordLength(query map[string][]string) (int, error) {
+	v, ok := query[wordLengthParam]
+	if !ok || len(v[0]) == 0 {
+		return words.DefaultNumLetters, nil
+	}
+	n, err := strconv.Atoi(v[0])
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("parsing %q: %w", wordLengthParam, err)
+	case n <= 0, n > maxWordLength:
+		return 0, fmt.Errorf("%q must be between 1 and %v", wordLengthParam, maxWordLength)
+	}
+	return n, nil
+}
 
-	var wc WordleCheater
-	var h result.History
+// parseDate parses the day of the puzzle, which is the day of now if it is not in the query
+func parseDate(query map[string][]string, now time.Time) (time.Time, error) {
+	v, ok := query[dateParam]
+	if !ok || len(v[0]) == 0 {
+		return now, nil
+	}
+	date, err := time.Parse(time.DateOnly, v[0])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing %q: %w", dateParam, err)
+	}
+	return date, nil
+}
+
+// Tiles creates a row of tiles for each scored guess
+func (wc WordleCheater) Tiles(noJS bool) [][]Tile {
+	var rs []result.Result
+	for _, r := range wc.Results {
+		if len(r.Guess) != 0 {
+			rs = append(rs, r)
+		}
+	}
+	if len(rs) == 0 {
+		return nil
+	}
+	query := wc.optionsQuery()
+	if noJS {
+		query.Set("NoJS", "")
+	}
+	for i, r := range rs {
+		query.Set(fmt.Sprintf("g%v", i), string(r.Guess))
+		query.Set(fmt.Sprintf("s%v", i), string(r.Score))
+	}
+	const nextScore = "acn" // the score after n, a, and c
+	tiles := make([][]Tile, len(rs))
+	for i, r := range rs {
+		letters := []rune(string(r.Guess))
+		tiles[i] = make([]Tile, len(letters))
+		scoreKey := fmt.Sprintf("s%v", i)
+		for j, ch := range letters {
+			s := []byte(r.Score)
+			s[j] = nextScore[strings.IndexByte("nac", s[j])]
+			query.Set(scoreKey, string(s))
+			tiles[i][j] = Tile{
+				Letter: string(ch),
+				Score:  string(r.Score[j : j+1]),
+				Link:   "?" + query.Encode(),
+			}
+		}
+		query.Set(scoreKey, string(r.Score))
+	}
+	return tiles
+}
+
+// optionsQuery creates the query parameters for the options of the page, excluding the results
+func (wc WordleCheater) optionsQuery() url.Values {
+	query := url.Values{
+		wordLengthParam: {strconv.Itoa(wc.WordLength)},
+	}
+	for k, v := range map[string]bool{
+		"HardMode":        wc.HardMode,
+		"ShowPossible":    wc.ShowPossible,
+		"ShowStats":       wc.ShowStats,
+		"ShowSuggestions": wc.ShowSuggestions,
+		"SuggestFromAll":  wc.SuggestFromAll,
+	} {
+		if v {
+			query.Set(k, "")
+		}
+	}
+	if wc.Alphabet != nil {
+		query.Set(alphabetParam, wc.Alphabet.Name())
+	}
+	for k, v := range map[string]string{
+		"WhyNot":        wc.WhyNot,
+		dateParam:       wc.Date,
+		correctParam:    wc.Constraints.Correct,
+		almostParam:     wc.Constraints.Almost,
+		excludedParam:   wc.Constraints.Excluded,
+		dictionaryParam: wc.Dictionary,
+		strategyParam:   string(wc.SuggestStrategy),
+		"Share":         wc.Share,
+	} {
+		if len(v) != 0 {
+			query.Set(k, v)
+		}
+	}
+	return query
+}
 
+// ShareCode encodes the results that have been scored so the page can be opened with them later.
+// No share code is created when the results are contradictory.
+func (wc WordleCheater) ShareCode() string {
+	if wc.Contradiction != nil {
+		return ""
+	}
+	var rs result.Results
+	for _, r := range wc.Results {
+		if len(r.Guess) != 0 {
+			rs = append(rs, r)
+		}
+	}
+	code, err := rs.ShareCode()
+	if err != nil {
+		return ""
+	}
+	return code
+}
+
+// parseShareCode parses the results of the share code, if any
+func parseShareCode(query map[string][]string) (result.Results, error) {
+	v, ok := query[shareCodeParam]
+	if !ok {
+		return nil, nil
+	}
+	rs, err := result.ParseShareCode(v[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", shareCodeParam, err)
+	}
+	return rs, nil
+}
+
+func newWordleCheater(query map[string][]string, ww wordleWords, numLetters int, shared result.Results) (*WordleCheater, error) {
+	wc := WordleCheater{
+		WordLength: numLetters,
+		Alphabet:   ww.alphabet,
+	}
+	h := result.NewHistory(ww.alphabet)
+	m := *ww.Answers.Copy()
+	all := ww.Guesses // shared, so it is copied before it is filtered
+
+	if ww.pastAnswers != nil {
+		wc.Date = ww.date.Format(time.DateOnly)
+		wc.PastAnswers = ww.pastAnswers.Remove(&m, ww.date)
+	}
+
+	if _, ok := query["HardMode"]; ok {
+		wc.HardMode = true
+	}
+
+	wc.Constraints = result.Constraints{
+		Correct:  firstParam(query, correctParam),
+		Almost:   firstParam(query, almostParam),
+		Excluded: firstParam(query, excludedParam),
+	}
+	if err := h.AddConstraints(wc.Constraints, numLetters, &m); err != nil {
+		return nil, fmt.Errorf("adding constraints: %w", err)
+	}
+
+	results := make([]*result.Result, 0, len(shared)+10)
+	for i := range shared {
+		if len(shared[i].Guess) != numLetters {
+			return nil, fmt.Errorf("shared result %v must be %v letters long", i+1, numLetters)
+		}
+		results = append(results, &shared[i])
+	}
 	for i := range 10 {
-		r, err := parseResult(query, i)
-		switch {
-		case err != nil:
+		r, err := parseResult(query, i, numLetters)
+		if err != nil {
 			return nil, err
-		case r != nil:
-			h.AddResult(*r, &m)
-			wc.Results = append(wc.Results, *r)
+		}
+		results = append(results, r)
+	}
+
+	for _, r := range results {
+		switch {
+		case r == nil:
+			// NOOP
+		case wc.Contradiction != nil:
+			wc.Results = append(wc.Results, *r) // keep later results so they can be corrected
+		case wc.HardMode:
+			if err := h.ValidateHardMode(r.Guess); err != nil {
+				return nil, fmt.Errorf("hard mode guess %v: %w", len(wc.Results)+1, err)
+			}
+			fallthrough
+		default:
+			if err := wc.addResult(&h, *r, &m); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	if _, ok := query["ShowPossible"]; ok {
 		wc.ShowPossible = true
 	}
+	if _, ok := query["ShowSuggestions"]; ok {
+		wc.ShowSuggestions = true
+	}
+	if _, ok := query["SuggestFromAll"]; ok {
+		wc.SuggestFromAll = true
+	}
+	if _, ok := query["ShowStats"]; ok {
+		wc.ShowStats = true
+	}
+	if v := firstParam(query, strategyParam); len(v) != 0 {
+		s, err := recommend.ParseStrategy(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %w", strategyParam, err)
+		}
+		wc.SuggestStrategy = s
+	}
 
-	wc.Done = len(wc.Results) >= 9 ||
-		(len(wc.Results) > 0 && wc.Results[len(wc.Results)-1].Score == score.AllCorrect)
-	if !wc.Done {
+	wc.Done = wc.Contradiction == nil && (len(wc.Results) >= 9 ||
+		(len(wc.Results) > 0 && wc.Results[len(wc.Results)-1].Score == score.AllCorrect(numLetters)))
+	if !wc.Done && wc.Contradiction == nil {
 		wc.Results = append(wc.Results, result.Result{})
 	}
 
-	if wc.ShowPossible {
+	var sh *score.Share
+	if v, ok := query["Share"]; ok && len(v[0]) != 0 {
+		wc.Share = v[0]
+		var err error
+		if sh, err = score.ParseShare(wc.Share); err != nil {
+			return nil, fmt.Errorf("parsing shared grid: %w", err)
+		}
+		if len(sh.Scores[0]) != numLetters {
+			return nil, fmt.Errorf("shared grid rows must be %v letters long", numLetters)
+		}
+		if wc.Contradiction == nil {
+			wc.filterShare(*sh, &m, all)
+		}
+	}
+
+	if wc.ShowPossible && wc.Contradiction == nil {
 		wc.Possible = make([]string, 0, len(m))
-		for k := range m {
-			wc.Possible = append(wc.Possible, k)
+		if ww.priors != nil {
+			wc.Probabilities = ww.priors.Probabilities(m)
+			for _, p := range wc.Probabilities {
+				wc.Possible = append(wc.Possible, p.Word)
+			}
+		} else {
+			for k := range m {
+				wc.Possible = append(wc.Possible, k)
+			}
+			slices.Sort(wc.Possible)
+		}
+	}
+
+	if wc.ShowStats && wc.Contradiction == nil {
+		stats := letter_stats.New(m)
+		wc.Stats = &stats
+	}
+
+	if v, ok := query["WhyNot"]; ok && len(v[0]) != 0 && wc.Contradiction == nil {
+		wc.WhyNot = v[0]
+		wc.WhyNotReasons = explainWhyNot(h, sh, ww, wc.WhyNot)
+	}
+
+	if wc.ShowSuggestions && !wc.Done && wc.Contradiction == nil && ww.tree != nil {
+		wc.lookUpTreeGuess(*ww.tree)
+	}
+
+	if wc.ShowSuggestions && !wc.Done && wc.Contradiction == nil && len(wc.TreeGuess) == 0 {
+		r := recommend.Recommender{
+			Strategy:  wc.SuggestStrategy,
+			FromAll:   wc.SuggestFromAll,
+			Count:     suggestionCount,
+			Priors:    ww.priors,
+			MaxScores: suggestionMaxScores,
+		}
+		if wc.HardMode {
+			all = *all.Copy()
+			h.FilterHardMode(&all)
 		}
-		slices.Sort(wc.Possible)
+		wc.Suggestions = r.Rank(m, all)
 	}
 
 	return &wc, nil
 }
 
-func parseResult(query map[string][]string, i int) (*result.Result, error) {
+// lookUpTreeGuess finds the guess of the tree to make after the scored results, recording why if it does not have one
+func (wc *WordleCheater) lookUpTreeGuess(t decision_tree.Tree) {
+	var rs result.Results
+	for _, r := range wc.Results {
+		if len(r.Guess) != 0 {
+			rs = append(rs, r)
+		}
+	}
+	g, err := t.NextGuess(rs)
+	if err != nil {
+		wc.OffTree = err.Error()
+		return
+	}
+	wc.TreeGuess = g
+}
+
+// addResult adds the result to the history, recording it as the contradiction if it conflicts with earlier results
+func (wc *WordleCheater) addResult(h *result.History, r result.Result, m *words.Words) error {
+	wc.Results = append(wc.Results, r)
+	err := h.AddResult(r, m)
+	var ce result.ContradictionError
+	switch {
+	case errors.As(err, &ce):
+		wc.Contradiction = &ce
+	case err != nil:
+		return fmt.Errorf("adding result %v: %w", len(wc.Results), err)
+	}
+	return nil
+}
+
+// explainWhyNot lists the reasons the word can not be the answer
+func explainWhyNot(h result.History, sh *score.Share, ww wordleWords, w string) []string {
+	if _, ok := ww.Guesses[w]; !ok {
+		return []string{"not in the word list"}
+	}
+	if _, ok := ww.Answers[w]; !ok {
+		return []string{"not in the answer list"}
+	}
+	if used, ok := ww.pastAnswers.UsedBefore(w, ww.date); ok {
+		return []string{"already the answer on " + used.Format(time.DateOnly)}
+	}
+	reasons := h.Explain(w)
+	if sh != nil && !sh.Allows(w, slices.Sorted(maps.Keys(ww.Guesses))) {
+		reasons = append(reasons, "could not have produced the shared grid")
+	}
+	return reasons
+}
+
+// filterShare removes the possible words that could not be the answer of the shared grid.
+// If only one word is left, the guesses that could have been made for each row of the grid are recorded.
+func (wc *WordleCheater) filterShare(sh score.Share, m *words.Words, all words.Words) {
+	guesses := slices.Sorted(maps.Keys(all))
+	for w := range *m {
+		if !sh.Allows(w, guesses) {
+			delete(*m, w)
+		}
+	}
+	if len(*m) != 1 {
+		return
+	}
+	for answer := range *m {
+		for i, path := range sh.Paths(answer, guesses) {
+			p := SharePath{
+				Score:   sh.Scores[i],
+				Count:   len(path),
+				Guesses: path[:min(len(path), maxPathGuesses)],
+			}
+			wc.SharePaths = append(wc.SharePaths, p)
+		}
+	}
+}
+
+func parseResult(query map[string][]string, i, numLetters int) (*result.Result, error) {
 	guessKey := fmt.Sprintf("g%v", i)
 	scoreKey := fmt.Sprintf("s%v", i)
 
@@ -92,11 +522,11 @@ func parseResult(query map[string][]string, i int) (*result.Result, error) {
 		return nil, nil
 	}
 	var anyWord words.Words
-	if err := g.Validate(anyWord); err != nil {
+	if err := g.Validate(anyWord, numLetters); err != nil {
 		return nil, fmt.Errorf("reading guess: %w", err)
 	}
 
-	if err := s.Validate(); err != nil {
+	if err := s.Validate(numLetters); err != nil {
 		return nil, fmt.Errorf("reading score: %w", err)
 	}
 
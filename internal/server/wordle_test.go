@@ -2,13 +2,22 @@ package server
 
 import (
 	"reflect"
+	"slices"
 	"strconv"
 	"testing"
+	"time"
 
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/letter_stats"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
 )
 
 func TestRunWordleCheater(t *testing.T) {
+	const oneOfThreeEntropy = 0.9182958340544896 // only one of the three words is distinguished from the others by each guess
 	tests := []struct {
 		name   string
 		query  map[string][]string
@@ -19,6 +28,7 @@ func TestRunWordleCheater(t *testing.T) {
 			name:   "empty",
 			wantOk: true,
 			want: WordleCheater{
+				WordLength: 5,
 				Results: []result.Result{
 					{},
 				},
@@ -31,7 +41,8 @@ func TestRunWordleCheater(t *testing.T) {
 			},
 			wantOk: true,
 			want: WordleCheater{
-				Results: []result.Result{{}},
+				WordLength: 5,
+				Results:    []result.Result{{}},
 			},
 		},
 		{
@@ -61,6 +72,7 @@ func TestRunWordleCheater(t *testing.T) {
 			},
 			wantOk: true,
 			want: WordleCheater{
+				WordLength: 5,
 				Results: []result.Result{
 					{Guess: "forts", Score: "ccccn"},
 					{},
@@ -69,6 +81,84 @@ func TestRunWordleCheater(t *testing.T) {
 				ShowPossible: true,
 			},
 		},
+		{
+			name: "suggestions",
+			query: map[string][]string{
+				"g0":              {"forts"},
+				"s0":              {"ccccn"},
+				"ShowSuggestions": {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{},
+				},
+				Suggestions: []recommend.Recommendation{
+					{Guess: "forte", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forth", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forty", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+				},
+				ShowSuggestions: true,
+			},
+		},
+		{
+			name: "suggestions from all",
+			query: map[string][]string{
+				"g0":              {"forts"},
+				"s0":              {"ccccn"},
+				"ShowSuggestions": {""},
+				"SuggestFromAll":  {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{},
+				},
+				Suggestions: []recommend.Recommendation{
+					{Guess: "forte", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forth", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forty", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forts", Largest: 3, Outcomes: 1},
+				},
+				ShowSuggestions: true,
+				SuggestFromAll:  true,
+			},
+		},
+		{
+			name: "minimax suggestions",
+			query: map[string][]string{
+				"g0":              {"forts"},
+				"s0":              {"ccccn"},
+				"ShowSuggestions": {""},
+				"SuggestStrategy": {"minimax"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{},
+				},
+				Suggestions: []recommend.Recommendation{
+					{Guess: "forte", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forth", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+					{Guess: "forty", Entropy: oneOfThreeEntropy, Largest: 2, Outcomes: 2, Possible: true},
+				},
+				ShowSuggestions: true,
+				SuggestStrategy: recommend.Minimax,
+			},
+		},
+		{
+			name: "unknown suggestion strategy",
+			query: map[string][]string{
+				"ShowSuggestions": {""},
+				"SuggestStrategy": {"best"},
+			},
+		},
 		{
 			name: "two guesses",
 			query: map[string][]string{
@@ -79,6 +169,7 @@ func TestRunWordleCheater(t *testing.T) {
 			},
 			wantOk: true,
 			want: WordleCheater{
+				WordLength: 5,
 				Results: []result.Result{
 					{Guess: "forts", Score: "ccccn"},
 					{Guess: "forth", Score: "ccccn"},
@@ -94,6 +185,7 @@ func TestRunWordleCheater(t *testing.T) {
 			},
 			wantOk: true,
 			want: WordleCheater{
+				WordLength: 5,
 				Results: []result.Result{
 					{Guess: "forts", Score: "ccccc"},
 				},
@@ -108,6 +200,7 @@ func TestRunWordleCheater(t *testing.T) {
 			},
 			wantOk: true,
 			want: WordleCheater{
+				WordLength: 5,
 				Results: []result.Result{
 					{Guess: "forts", Score: "ccccn"},
 					{},
@@ -123,6 +216,7 @@ func TestRunWordleCheater(t *testing.T) {
 			},
 			wantOk: true,
 			want: WordleCheater{
+				WordLength: 5,
 				Results: []result.Result{
 					{Guess: "forts", Score: "ccccn"},
 					{},
@@ -138,10 +232,224 @@ func TestRunWordleCheater(t *testing.T) {
 			},
 			wantOk: true,
 			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{},
+				},
+			},
+		},
+		{
+			name: "word length",
+			query: map[string][]string{
+				"WordLength":   {"4"},
+				"g0":           {"fort"},
+				"s0":           {"cccn"},
+				"ShowPossible": {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 4,
+				Results: []result.Result{
+					{Guess: "fort", Score: "cccn"},
+					{},
+				},
+				Possible:     []string{"form"},
+				ShowPossible: true,
+			},
+		},
+		{
+			name: "empty word length",
+			query: map[string][]string{
+				"WordLength": {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results:    []result.Result{{}},
+			},
+		},
+		{
+			name: "word length mismatch",
+			query: map[string][]string{
+				"WordLength": {"4"},
+				"g0":         {"forts"},
+				"s0":         {"ccccn"},
+			},
+		},
+		{
+			name: "bad word length",
+			query: map[string][]string{
+				"WordLength": {"four"},
+			},
+		},
+		{
+			name: "word length too large",
+			query: map[string][]string{
+				"WordLength": {"99"},
+			},
+		},
+		{
+			name: "hard mode",
+			query: map[string][]string{
+				"HardMode":        {""},
+				"g0":              {"forts"},
+				"s0":              {"ccccn"},
+				"g1":              {"forty"},
+				"s1":              {"ccccn"},
+				"ShowSuggestions": {""},
+				"SuggestFromAll":  {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
 				Results: []result.Result{
 					{Guess: "forts", Score: "ccccn"},
+					{Guess: "forty", Score: "ccccn"},
 					{},
 				},
+				Suggestions: []recommend.Recommendation{
+					{Guess: "forte", Entropy: 1, Largest: 1, Outcomes: 2, Possible: true},
+					{Guess: "forth", Entropy: 1, Largest: 1, Outcomes: 2, Possible: true},
+					{Guess: "forts", Largest: 2, Outcomes: 1},
+					{Guess: "forty", Largest: 2, Outcomes: 1},
+				},
+				ShowSuggestions: true,
+				SuggestFromAll:  true,
+				HardMode:        true,
+			},
+		},
+		{
+			name: "hard mode violation",
+			query: map[string][]string{
+				"HardMode": {""},
+				"g0":       {"forts"},
+				"s0":       {"ccccn"},
+				"g1":       {"xxxxx"},
+				"s1":       {"nnnnn"},
+			},
+		},
+		{
+			name: "contradiction",
+			query: map[string][]string{
+				"g0":           {"forts"},
+				"s0":           {"ccccn"},
+				"g1":           {"forty"},
+				"s1":           {"nnnnn"},
+				"g2":           {"forte"},
+				"s2":           {"ccccc"},
+				"ShowPossible": {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{Guess: "forty", Score: "nnnnn"},
+					{Guess: "forte", Score: "ccccc"},
+				},
+				ShowPossible: true,
+				Contradiction: &result.ContradictionError{
+					Row:      2,
+					Letter:   'f',
+					Fact:     "F is not the 1st letter",
+					Conflict: "1st letter is F in an earlier result",
+				},
+			},
+		},
+		{
+			name: "why not",
+			query: map[string][]string{
+				"g0":     {"forts"},
+				"s0":     {"ccccn"},
+				"WhyNot": {"forts"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{},
+				},
+				WhyNot:        "forts",
+				WhyNotReasons: []string{"'s' is prohibited at position 5"},
+			},
+		},
+		{
+			name: "why not possible word",
+			query: map[string][]string{
+				"g0":     {"forts"},
+				"s0":     {"ccccn"},
+				"WhyNot": {"forty"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{},
+				},
+				WhyNot: "forty",
+			},
+		},
+		{
+			name: "why not unknown word",
+			query: map[string][]string{
+				"WhyNot": {"fjord"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength:    5,
+				Results:       []result.Result{{}},
+				WhyNot:        "fjord",
+				WhyNotReasons: []string{"not in the word list"},
+			},
+		},
+		{
+			name: "share code",
+			query: map[string][]string{
+				"Code": {"BQ8qMzk4"}, // forts ccccn
+				"g0":   {"forty"},
+				"s0":   {"ccccn"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{Guess: "forty", Score: "ccccn"},
+					{},
+				},
+			},
+		},
+		{
+			name: "share code word length",
+			query: map[string][]string{
+				"Code":         {"BA8qMzs"}, // fort cccn
+				"ShowPossible": {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 4,
+				Results: []result.Result{
+					{Guess: "fort", Score: "cccn"},
+					{},
+				},
+				Possible:     []string{"form"},
+				ShowPossible: true,
+			},
+		},
+		{
+			name: "share code word length mismatch",
+			query: map[string][]string{
+				"Code":       {"BA8qMzs"},
+				"WordLength": {"5"},
+			},
+		},
+		{
+			name: "invalid share code",
+			query: map[string][]string{
+				"Code": {"!"},
 			},
 		},
 		{
@@ -161,8 +469,8 @@ func TestRunWordleCheater(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			words := "forte forth forts forty"
-			got, err := NewWordleCheater(test.query, words)
+			words := "forte forth forts forty form"
+			got, err := NewWordleCheater(test.query, WordsText{Words: words})
 			switch {
 			case !test.wantOk:
 				if err == nil {
@@ -178,9 +486,12 @@ func TestRunWordleCheater(t *testing.T) {
 }
 
 func TestRunWordleCheaterBadWordsText(t *testing.T) {
-	if _, err := NewWordleCheater(map[string][]string{}, "Words"); err == nil {
+	if _, err := NewWordleCheater(map[string][]string{}, WordsText{Words: "Words"}); err == nil {
 		t.Errorf("wanted error running with capitalized word")
 	}
+	if _, err := NewWordleCheater(map[string][]string{}, WordsText{Words: "words", Answers: "Words"}); err == nil {
+		t.Errorf("wanted error running with capitalized answer")
+	}
 }
 
 func TestRunWordleCheaterGuessCount(t *testing.T) {
@@ -202,7 +513,7 @@ func TestRunWordleCheaterGuessCount(t *testing.T) {
 					query["s"+strconv.Itoa(i)] = []string{"nnnnn"}
 				}
 				wordsText := "xxxxa xxxxb xxxxc xxxxd xxxxe xxxxf xxxxg xxxxh xxxxi xxxxj"
-				got, err := NewWordleCheater(query, wordsText)
+				got, err := NewWordleCheater(query, WordsText{Words: wordsText})
 				switch {
 				case err != nil:
 					t.Errorf("unwanted error: %v", err)
@@ -213,3 +524,623 @@ func TestRunWordleCheaterGuessCount(t *testing.T) {
 		}
 	})
 }
+
+func TestWordleCheaterShareCode(t *testing.T) {
+	tests := []struct {
+		name string
+		WordleCheater
+		want string
+	}{
+		{
+			name:          "no results",
+			WordleCheater: WordleCheater{Results: []result.Result{{}}},
+		},
+		{
+			name: "results",
+			WordleCheater: WordleCheater{
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{},
+				},
+			},
+			want: "BQ8qMzk4",
+		},
+		{
+			name: "contradiction",
+			WordleCheater: WordleCheater{
+				Results: []result.Result{
+					{Guess: "forts", Score: "ccccn"},
+					{Guess: "forty", Score: "nnnnn"},
+				},
+				Contradiction: &result.ContradictionError{},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.WordleCheater.ShareCode(); test.want != got {
+				t.Errorf("wanted %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNewWordleCheaterShare(t *testing.T) {
+	const wordsText = "bathe lathe tithe ghost"
+	tests := []struct {
+		name   string
+		query  map[string][]string
+		wantOk bool
+		want   WordleCheater
+	}{
+		{
+			name: "filter possible",
+			query: map[string][]string{
+				"g0":           {"ghost"},
+				"s0":           {"nanna"},
+				"Share":        {"⬛🟩🟩🟩🟩\n🟩🟩🟩🟩🟩"},
+				"ShowPossible": {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "ghost", Score: "nanna"},
+					{},
+				},
+				Possible:     []string{"bathe", "lathe"},
+				ShowPossible: true,
+				Share:        "⬛🟩🟩🟩🟩\n🟩🟩🟩🟩🟩",
+			},
+		},
+		{
+			name: "paths",
+			query: map[string][]string{
+				"g0":     {"lathe"},
+				"s0":     {"⬛🟩🟩🟩🟩"},
+				"Share":  {"Wordle 1 2/6\n⬛🟩🟩🟩🟩\n🟩🟩🟩🟩🟩"},
+				"WhyNot": {"tithe"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "lathe", Score: "ncccc"},
+					{},
+				},
+				Share: "Wordle 1 2/6\n⬛🟩🟩🟩🟩\n🟩🟩🟩🟩🟩",
+				SharePaths: []SharePath{
+					{Score: "ncccc", Count: 1, Guesses: []string{"lathe"}},
+					{Score: "ccccc", Count: 1, Guesses: []string{"bathe"}},
+				},
+				WhyNot:        "tithe",
+				WhyNotReasons: []string{"position 2 must be 'a'", "needs one 'a'", "could not have produced the shared grid"},
+			},
+		},
+		{
+			name: "invalid share",
+			query: map[string][]string{
+				"Share": {"Wordle"},
+			},
+		},
+		{
+			name: "share length mismatch",
+			query: map[string][]string{
+				"Share": {"🟩🟩🟩🟩"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewWordleCheater(test.query, WordsText{Words: wordsText})
+			switch {
+			case !test.wantOk:
+				if err == nil {
+					t.Error("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, *got):
+				t.Errorf("unequal: \n wanted: %+v \n got:    %+v", test.want, *got)
+			}
+		})
+	}
+}
+
+func TestNewWordleCheaterAnswers(t *testing.T) {
+	const wordsText, answersText = "bathe lathe tithe ghost", "lathe tithe"
+	tests := []struct {
+		name   string
+		query  map[string][]string
+		wantOk bool
+		want   WordleCheater
+	}{
+		{
+			name: "possible answers",
+			query: map[string][]string{
+				"g0":           {"crown"},
+				"s0":           {"nnnnn"},
+				"ShowPossible": {""},
+				"WhyNot":       {"bathe"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "crown", Score: "nnnnn"},
+					{},
+				},
+				Possible:      []string{"lathe", "tithe"},
+				ShowPossible:  true,
+				WhyNot:        "bathe",
+				WhyNotReasons: []string{"not in the answer list"},
+			},
+		},
+		{
+			name: "guess is not an answer",
+			query: map[string][]string{
+				"g0":           {"bathe"},
+				"s0":           {"nnccc"},
+				"ShowPossible": {""},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "bathe", Score: "nnccc"},
+					{},
+				},
+				Possible:     []string{"tithe"},
+				ShowPossible: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewWordleCheater(test.query, WordsText{Words: wordsText, Answers: answersText})
+			switch {
+			case !test.wantOk:
+				if err == nil {
+					t.Error("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, *got):
+				t.Errorf("unequal: \n wanted: %+v \n got:    %+v", test.want, *got)
+			}
+		})
+	}
+}
+
+func TestNewWordleCheaterFrequencies(t *testing.T) {
+	wt := WordsText{
+		Words:       "bathe lathe tithe",
+		Frequencies: "lathe 3\ntithe 1\n",
+	}
+	query := map[string][]string{
+		"g0":           {"crown"},
+		"s0":           {"nnnnn"},
+		"ShowPossible": {""},
+	}
+	want := WordleCheater{
+		WordLength: 5,
+		Results: []result.Result{
+			{Guess: "crown", Score: "nnnnn"},
+			{},
+		},
+		Possible:      []string{"lathe", "bathe", "tithe"},
+		Probabilities: []words.Probability{{Word: "lathe", Percent: 60}, {Word: "bathe", Percent: 20}, {Word: "tithe", Percent: 20}},
+		ShowPossible:  true,
+	}
+	for _, wt := range []WordsText{wt, mustIndex(t, wt)} {
+		got, err := NewWordleCheater(query, wt)
+		switch {
+		case err != nil:
+			t.Errorf("unwanted error: %v", err)
+		case !reflect.DeepEqual(want, *got):
+			t.Errorf("unequal: \n wanted: %+v \n got:    %+v", want, *got)
+		}
+	}
+	wt.Frequencies = "lathe often"
+	if _, err := NewWordleCheater(query, wt); err == nil {
+		t.Errorf("wanted error for invalid frequencies")
+	}
+	if _, err := wt.index(); err == nil {
+		t.Errorf("wanted error indexing invalid frequencies")
+	}
+}
+
+func TestNewWordleCheaterPastAnswers(t *testing.T) {
+	wt := WordsText{
+		Words:       "bathe crown lathe tithe",
+		PastAnswers: "2022-01-01 bathe\n2022-01-02 tithe\n",
+	}
+	tests := []struct {
+		name   string
+		query  map[string][]string
+		wantOk bool
+		want   WordleCheater
+	}{
+		{
+			name: "before date",
+			query: map[string][]string{
+				"g0":           {"crown"},
+				"s0":           {"nnnnn"},
+				"ShowPossible": {""},
+				"WhyNot":       {"bathe"},
+				"Date":         {"2022-01-02"},
+			},
+			wantOk: true,
+			want: WordleCheater{
+				WordLength: 5,
+				Results: []result.Result{
+					{Guess: "crown", Score: "nnnnn"},
+					{},
+				},
+				Possible:      []string{"lathe", "tithe"},
+				ShowPossible:  true,
+				WhyNot:        "bathe",
+				WhyNotReasons: []string{"already the answer on 2022-01-01"},
+				Date:          "2022-01-02",
+				PastAnswers:   1,
+			},
+		},
+		{
+			name: "bad date",
+			query: map[string][]string{
+				"Date": {"tomorrow"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, wt := range []WordsText{wt, mustIndex(t, wt)} {
+				got, err := NewWordleCheater(test.query, wt)
+				switch {
+				case !test.wantOk:
+					if err == nil {
+						t.Error("wanted error")
+					}
+				case err != nil:
+					t.Errorf("unwanted error: %v", err)
+				case !reflect.DeepEqual(test.want, *got):
+					t.Errorf("unequal: \n wanted: %+v \n got:    %+v", test.want, *got)
+				}
+			}
+		})
+	}
+	wt.PastAnswers = "yesterday bathe"
+	if _, err := NewWordleCheater(map[string][]string{}, wt); err == nil {
+		t.Errorf("wanted error for invalid past answers")
+	}
+	if _, err := wt.index(); err == nil {
+		t.Errorf("wanted error indexing invalid past answers")
+	}
+}
+
+// mustIndex parses the word lists to share them between requests
+func mustIndex(t testing.TB, wt WordsText) WordsText {
+	t.Helper()
+	indexed, err := wt.index()
+	if err != nil {
+		t.Fatalf("indexing words: %v", err)
+	}
+	return *indexed
+}
+
+func TestNewWordleCheaterSharedLists(t *testing.T) {
+	wt := mustIndex(t, WordsText{
+		Words:   "bathe crown lathe tithe",
+		Answers: "lathe tithe",
+	})
+	want, err := wt.wordsLexicon().Lists(wt.answersLexicon(), 5)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	query := map[string][]string{
+		"g0":              {"bathe"},
+		"s0":              {"nccca"},
+		"HardMode":        {""},
+		"SuggestFromAll":  {""},
+		"ShowSuggestions": {""},
+	}
+	if _, err := NewWordleCheater(query, wt); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	if got := wt.lexicons.lists[5]; !reflect.DeepEqual(want, got) {
+		t.Errorf("shared lists were modified: \n wanted: %+v \n got:    %+v", want, got)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	now := time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		query  map[string][]string
+		want   time.Time
+		wantOk bool
+	}{
+		{"default", map[string][]string{}, now, true},
+		{"empty", map[string][]string{"Date": {""}}, now, true},
+		{"date", map[string][]string{"Date": {"2021-06-19"}}, time.Date(2021, 6, 19, 0, 0, 0, 0, time.UTC), true},
+		{"invalid", map[string][]string{"Date": {"2021-13-01"}}, time.Time{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseDate(test.query, now)
+			switch {
+			case !test.wantOk:
+				if err == nil {
+					t.Error("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !test.want.Equal(got):
+				t.Errorf("wanted %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNewWordleCheaterStats(t *testing.T) {
+	query := map[string][]string{
+		"g0":        {"forts"},
+		"s0":        {"ccccn"},
+		"ShowStats": {""},
+	}
+	got, err := NewWordleCheater(query, WordsText{Words: "forte forth forts forty"})
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	want := &letter_stats.Stats{
+		Words: 3,
+		Letters: []letter_stats.Letter{
+			{Letter: 'f', Total: 3, Positions: []int{3, 0, 0, 0, 0}},
+			{Letter: 'o', Total: 3, Positions: []int{0, 3, 0, 0, 0}},
+			{Letter: 'r', Total: 3, Positions: []int{0, 0, 3, 0, 0}},
+			{Letter: 't', Total: 3, Positions: []int{0, 0, 0, 3, 0}},
+			{Letter: 'e', Total: 1, Positions: []int{0, 0, 0, 0, 1}},
+			{Letter: 'h', Total: 1, Positions: []int{0, 0, 0, 0, 1}},
+			{Letter: 'y', Total: 1, Positions: []int{0, 0, 0, 0, 1}},
+		},
+	}
+	switch {
+	case !got.ShowStats:
+		t.Errorf("wanted stats to be shown")
+	case !reflect.DeepEqual(want, got.Stats):
+		t.Errorf("stats not equal: \n wanted: %+v \n got:    %+v", want, got.Stats)
+	}
+}
+
+func TestNewWordleCheaterAlphabet(t *testing.T) {
+	query := map[string][]string{
+		"g0":           {"niños"},
+		"s0":           {"nncca"},
+		"ShowPossible": {""},
+	}
+	wordsText := "cañón niños señor"
+	if _, err := NewWordleCheater(query, WordsText{Words: wordsText}); err == nil {
+		t.Errorf("wanted error for guess that is not in the english alphabet")
+	}
+	query[alphabetParam] = []string{"spanish"}
+	got, err := NewWordleCheater(query, WordsText{Words: wordsText})
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case got.Alphabet != char_set.Spanish:
+		t.Errorf("wanted spanish alphabet, got %v", got.Alphabet.Name())
+	case !reflect.DeepEqual([]string{"señor"}, got.Possible):
+		t.Errorf("wanted only señor to be possible, got %v", got.Possible)
+	}
+}
+
+func TestNewWordleCheaterConstraints(t *testing.T) {
+	wordsText := "arose brace crane eerie grace rebar tiger trace"
+	tests := []struct {
+		name    string
+		query   map[string][]string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "constraints",
+			query: map[string][]string{
+				correctParam:  {"?r???"},
+				almostParam:   {"???e?"},
+				excludedParam: {"b"},
+			},
+			want: []string{"arose", "crane", "grace", "trace"},
+		},
+		{
+			name: "uppercase constraints",
+			query: map[string][]string{
+				correctParam:  {"?R???"},
+				almostParam:   {"???E?"},
+				excludedParam: {"B"},
+			},
+			want: []string{"arose", "crane", "grace", "trace"},
+		},
+		{
+			name: "constraints and results",
+			query: map[string][]string{
+				correctParam:  {"?r???"},
+				excludedParam: {"t"},
+				"g0":          {"brace"},
+				"s0":          {"ncccc"},
+			},
+			want: []string{"grace"},
+		},
+		{
+			name: "result contradicts constraints",
+			query: map[string][]string{
+				excludedParam: {"t"},
+				"g0":          {"trace"},
+				"s0":          {"acccc"},
+			},
+		},
+		{
+			name:    "bad constraints",
+			query:   map[string][]string{correctParam: {"??r?"}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.query["ShowPossible"] = []string{""}
+			got, err := NewWordleCheater(test.query, WordsText{Words: wordsText})
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case test.want == nil && got.Contradiction == nil:
+				t.Errorf("wanted contradiction")
+			case !slices.Equal(test.want, got.Possible):
+				t.Errorf("possible words not equal:\nwanted: %v\ngot:    %v", test.want, got.Possible)
+			}
+		})
+	}
+}
+
+func TestNewWordleCheaterTree(t *testing.T) {
+	tree, err := decision_tree.Parse([]byte("crane\n  accnc brace\n    ncccc grace\n"))
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	tests := []struct {
+		name            string
+		query           map[string][]string
+		wantTreeGuess   guess.Guess
+		wantOffTree     bool
+		wantSuggestions bool
+	}{
+		{
+			name:          "opener",
+			query:         map[string][]string{"ShowSuggestions": {""}},
+			wantTreeGuess: "crane",
+		},
+		{
+			name: "follows tree",
+			query: map[string][]string{
+				"g0":              {"crane"},
+				"s0":              {"accnc"},
+				"ShowSuggestions": {""},
+			},
+			wantTreeGuess: "brace",
+		},
+		{
+			name: "leaves tree",
+			query: map[string][]string{
+				"g0":              {"trace"},
+				"s0":              {"ncccc"},
+				"ShowSuggestions": {""},
+			},
+			wantOffTree:     true,
+			wantSuggestions: true,
+		},
+		{
+			name: "other word length",
+			query: map[string][]string{
+				wordLengthParam:   {"4"},
+				"ShowSuggestions": {""},
+			},
+			wantSuggestions: true,
+		},
+		{
+			name: "suggestions not shown",
+			query: map[string][]string{
+				"g0": {"crane"},
+				"s0": {"accnc"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wt := WordsText{
+				Words: "arose brace crane grace trace race",
+				Tree:  tree,
+			}
+			got, err := NewWordleCheater(test.query, wt)
+			switch {
+			case err != nil:
+				t.Fatalf("unwanted error: %v", err)
+			case test.wantTreeGuess != got.TreeGuess:
+				t.Errorf("tree guesses not equal: wanted %q, got %q", test.wantTreeGuess, got.TreeGuess)
+			case test.wantOffTree != (len(got.OffTree) != 0):
+				t.Errorf("wanted off tree: %v, got %q", test.wantOffTree, got.OffTree)
+			case test.wantSuggestions != (len(got.Suggestions) != 0):
+				t.Errorf("wanted suggestions: %v, got %v", test.wantSuggestions, got.Suggestions)
+			}
+		})
+	}
+}
+
+func TestWordleCheaterTiles(t *testing.T) {
+	tests := []struct {
+		name string
+		WordleCheater
+		noJS bool
+		want [][]Tile
+	}{
+		{
+			name:          "no results",
+			WordleCheater: WordleCheater{WordLength: 3, Results: []result.Result{{}}},
+		},
+		{
+			name: "cycle scores",
+			WordleCheater: WordleCheater{
+				WordLength: 3,
+				Results: []result.Result{
+					{Guess: "abc", Score: "can"},
+					{},
+				},
+				HardMode: true,
+			},
+			want: [][]Tile{{
+				{Letter: "a", Score: "c", Link: "?HardMode=&WordLength=3&g0=abc&s0=nan"},
+				{Letter: "b", Score: "a", Link: "?HardMode=&WordLength=3&g0=abc&s0=ccn"},
+				{Letter: "c", Score: "n", Link: "?HardMode=&WordLength=3&g0=abc&s0=caa"},
+			}},
+		},
+		{
+			name: "letters",
+			WordleCheater: WordleCheater{
+				WordLength: 2,
+				Alphabet:   char_set.Spanish,
+				Results: []result.Result{
+					{Guess: "ñu", Score: "cn"},
+				},
+			},
+			want: [][]Tile{{
+				{Letter: "ñ", Score: "c", Link: "?Alphabet=spanish&WordLength=2&g0=%C3%B1u&s0=nn"},
+				{Letter: "u", Score: "n", Link: "?Alphabet=spanish&WordLength=2&g0=%C3%B1u&s0=ca"},
+			}},
+		},
+		{
+			name: "keep other results and options",
+			WordleCheater: WordleCheater{
+				WordLength: 1,
+				Results: []result.Result{
+					{Guess: "a", Score: "n"},
+					{Guess: "b", Score: "c"},
+				},
+				ShowPossible: true,
+				WhyNot:       "c",
+			},
+			noJS: true,
+			want: [][]Tile{
+				{{Letter: "a", Score: "n", Link: "?NoJS=&ShowPossible=&WhyNot=c&WordLength=1&g0=a&g1=b&s0=a&s1=c"}},
+				{{Letter: "b", Score: "c", Link: "?NoJS=&ShowPossible=&WhyNot=c&WordLength=1&g0=a&g1=b&s0=n&s1=n"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.WordleCheater.Tiles(test.noJS); !reflect.DeepEqual(test.want, got) {
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
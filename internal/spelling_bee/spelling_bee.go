@@ -3,7 +3,9 @@ package spelling_bee
 import (
 	"slices"
 	"strings"
+	"unicode/utf8"
 
+	words "github.com/jacobpatterson1549/wordle-cheater"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
 )
 
@@ -12,6 +14,8 @@ type (
 		CentralLetter rune
 		OtherLetters  string
 		MinLength     int
+		// Alphabet is the letters that words can have, English if nil
+		Alphabet *char_set.Alphabet
 	}
 	wordsConfig struct {
 		sb           SpellingBee
@@ -26,28 +30,36 @@ type (
 )
 
 func (sb SpellingBee) Words(wordsText string) []Word {
-	lines := strings.Fields(wordsText)
+	return sb.LexiconWords(words.NewLexicon(wordsText))
+}
+
+// LexiconWords finds the words of the lexicon that only use the letters and have the central letter
+func (sb SpellingBee) LexiconWords(l *words.Lexicon) []Word {
 	cfg := sb.newWordsConfig()
-	var words []Word
-	for _, value := range lines {
+	if cfg.validLetters.IsEmpty() {
+		return nil
+	}
+	var found []Word
+	for _, value := range l.WithLetters(string(sb.CentralLetter) + sb.OtherLetters) {
 		letters := cfg.letters(value)
-		if letters != 0 {
+		if !letters.IsEmpty() {
 			w := cfg.newWord(value, letters)
-			words = append(words, w)
+			found = append(found, w)
 		}
 	}
-	slices.SortFunc(words, wordLess)
-	return words
+	slices.SortFunc(found, wordLess)
+	return found
 }
 
 func (sb SpellingBee) newWordsConfig() wordsConfig {
 	var cfg wordsConfig
-	if !lowercase(sb.CentralLetter) {
+	if !sb.Alphabet.Has(sb.CentralLetter) {
 		return cfg
 	}
+	cfg.validLetters = sb.Alphabet.NewCharSet()
 	cfg.validLetters.Add(sb.CentralLetter)
 	for _, r := range sb.OtherLetters {
-		if lowercase(r) {
+		if sb.Alphabet.Has(r) {
 			cfg.validLetters.Add(r)
 		}
 	}
@@ -57,18 +69,18 @@ func (sb SpellingBee) newWordsConfig() wordsConfig {
 }
 
 func (cfg wordsConfig) letters(value string) char_set.CharSet {
-	if len(value) < cfg.sb.MinLength {
-		return 0
+	letters := cfg.sb.Alphabet.NewCharSet()
+	if utf8.RuneCountInString(value) < cfg.sb.MinLength {
+		return letters
 	}
-	var letters char_set.CharSet
 	for _, r := range value {
 		if !cfg.validLetters.Has(r) {
-			return 0
+			return cfg.sb.Alphabet.NewCharSet()
 		}
 		letters.Add(r)
 	}
 	if !letters.Has(cfg.sb.CentralLetter) {
-		return 0
+		return cfg.sb.Alphabet.NewCharSet()
 	}
 	return letters
 }
@@ -79,8 +91,8 @@ func (cfg wordsConfig) newWord(value string, letters char_set.CharSet) Word {
 		Score:     1,
 		IsPangram: letters == cfg.validLetters,
 	}
-	if cfg.sb.MinLength < len(value) {
-		w.Score += len(value) - 1
+	if n := utf8.RuneCountInString(value); cfg.sb.MinLength < n {
+		w.Score += n - 1
 	}
 	if w.IsPangram {
 		w.Score += cfg.numLetters
@@ -88,10 +100,6 @@ func (cfg wordsConfig) newWord(value string, letters char_set.CharSet) Word {
 	return w
 }
 
-func lowercase(r rune) bool {
-	return 'a' <= r && r <= 'z'
-}
-
 func wordLess(a, b Word) int {
 	switch {
 	case a.Score != b.Score:
@@ -100,8 +108,8 @@ func wordLess(a, b Word) int {
 		return -1
 	case a.IsPangram != b.IsPangram && a.IsPangram:
 		return 1
-	case len(a.Value) != len(b.Value):
-		return len(a.Value) - len(b.Value)
+	case utf8.RuneCountInString(a.Value) != utf8.RuneCountInString(b.Value):
+		return utf8.RuneCountInString(a.Value) - utf8.RuneCountInString(b.Value)
 	case a.Value != b.Value:
 		return strings.Compare(a.Value, b.Value)
 	}
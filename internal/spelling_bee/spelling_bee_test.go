@@ -3,6 +3,8 @@ package spelling_bee
 import (
 	"slices"
 	"testing"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
 )
 
 func TestGetScores(t *testing.T) {
@@ -31,6 +33,20 @@ func TestGetScores(t *testing.T) {
 				{Score: 6, Value: "fun", IsPangram: true},
 			},
 		},
+		{
+			name:      "spanish",
+			sb:        SpellingBee{CentralLetter: 'ñ', OtherLetters: "ion", MinLength: 4, Alphabet: char_set.Spanish},
+			wordsText: "niño año ñoño niña",
+			want: []Word{
+				{Score: 1, Value: "ñoño"},
+				{Score: 5, Value: "niño", IsPangram: true},
+			},
+		},
+		{
+			name:      "central letter not in alphabet",
+			sb:        SpellingBee{CentralLetter: 'ñ', OtherLetters: "ion", MinLength: 4},
+			wordsText: "niño año ñoño niña",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -0,0 +1,88 @@
+// Package word_lists loads the words of the command-line programs from the files named by their flags.
+package word_lists
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+)
+
+// Flags name the files of the words to load
+type Flags struct {
+	// Dictionaries are comma-separated word list files or directories
+	Dictionaries string
+	// Dictionary is the name of the dictionary to use, the default dictionary if empty
+	Dictionary string
+	// GuessesPath is a file of words that replaces the embedded words as the default dictionary
+	GuessesPath string
+	// AnswersPath is a file of the words that can be answers.  It is only used with the default dictionary.
+	AnswersPath string
+}
+
+const (
+	// DictionariesUsage describes the flag of the files of the dictionaries
+	DictionariesUsage = "comma-separated word list files or directories of .txt files, which can be named: spanish=spanish.txt (the embedded words are the default)"
+	// GuessesUsage describes the flag of the file of the guesses
+	GuessesUsage = "a file of the words that can be guessed, which replaces the embedded words as the default dictionary"
+	// DefaultOnly is appended to the usage of the flags of files that describe the default dictionary
+	DefaultOnly = " (only used with the default dictionary)"
+)
+
+// AddDictionaryFlags registers the flags that load and pick the dictionaries, defaulting to the DICTIONARIES and DICTIONARY environment variables
+func (f *Flags) AddDictionaryFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.Dictionaries, "dictionaries", os.Getenv("DICTIONARIES"), DictionariesUsage)
+	fs.StringVar(&f.Dictionary, "dictionary", os.Getenv("DICTIONARY"), "the name of the dictionary to use (defaults to the embedded words)")
+}
+
+// AddListFlags registers the dictionary flags and the flags of the files of the guesses and answers
+func (f *Flags) AddListFlags(fs *flag.FlagSet) {
+	f.AddDictionaryFlags(fs)
+	fs.StringVar(&f.GuessesPath, "guesses", "", GuessesUsage)
+	fs.StringVar(&f.AnswersPath, "answers", "", "a file of the words that can be answers, all words are used if empty"+DefaultOnly)
+}
+
+// Lexicon loads the dictionaries and returns the words of the picked one
+func (f Flags) Lexicon() (*words.Lexicon, error) {
+	loaded, err := words.LoadDictionaries(f.Dictionaries)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.GuessesPath) != 0 {
+		if err := loaded.LoadDefault(f.GuessesPath); err != nil {
+			return nil, err
+		}
+	}
+	return loaded.Lexicon(f.Dictionary)
+}
+
+// Lists loads the guesses and answers that are numLetters long
+func (f Flags) Lists(numLetters int) (*words.Lists, error) {
+	lexicon, err := f.Lexicon()
+	if err != nil {
+		return nil, err
+	}
+	answersText, err := f.ReadDefaultFile(f.AnswersPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading answers: %w", err)
+	}
+	lists, err := lexicon.Lists(words.NewLexicon(answersText), numLetters)
+	if err != nil {
+		return nil, fmt.Errorf("loading words: %w", err)
+	}
+	return lists, nil
+}
+
+// ReadDefaultFile reads a file that describes the words of the default dictionary, like the answers.
+// The text is empty if the path is empty or another dictionary is picked, the same as on the server.
+func (f Flags) ReadDefaultFile(path string) (string, error) {
+	if len(path) == 0 || !words.IsDefaultDictionary(f.Dictionary) {
+		return "", nil
+	}
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
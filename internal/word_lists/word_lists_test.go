@@ -0,0 +1,92 @@
+package word_lists
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+)
+
+func TestAddListFlags(t *testing.T) {
+	var f Flags
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	f.AddListFlags(fs)
+	args := []string{"-dictionaries", "es=spanish.txt", "-dictionary", "es", "-guesses", "guesses.txt", "-answers", "answers.txt"}
+	if err := fs.Parse(args); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	want := Flags{
+		Dictionaries: "es=spanish.txt",
+		Dictionary:   "es",
+		GuessesPath:  "guesses.txt",
+		AnswersPath:  "answers.txt",
+	}
+	if want != f {
+		t.Errorf("not equal:\nwanted: %+v\ngot:    %+v", want, f)
+	}
+}
+
+func TestFlagsLists(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, text string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			t.Fatalf("writing file: %v", err)
+		}
+		return path
+	}
+	guesses := writeFile("guesses.txt", "apple berry cherry")
+	answers := writeFile("answers.txt", "berry")
+	spanish := writeFile("spanish.txt", "niños señor")
+	tests := []struct {
+		name string
+		Flags
+		want    *words.Lists
+		wantErr bool
+	}{
+		{
+			name:  "guesses and answers",
+			Flags: Flags{GuessesPath: guesses, AnswersPath: answers},
+			want: &words.Lists{
+				Guesses: words.Words{"apple": {}, "berry": {}},
+				Answers: words.Words{"berry": {}},
+			},
+		},
+		{
+			name:  "answers only used with the default dictionary",
+			Flags: Flags{Dictionaries: spanish, Dictionary: "spanish", GuessesPath: guesses, AnswersPath: answers},
+			want: &words.Lists{
+				Guesses: words.Words{"niños": {}, "señor": {}},
+				Answers: words.Words{"niños": {}, "señor": {}},
+			},
+		},
+		{
+			name:    "missing answers",
+			Flags:   Flags{AnswersPath: filepath.Join(dir, "missing.txt")},
+			wantErr: true,
+		},
+		{
+			name:    "unknown dictionary",
+			Flags:   Flags{Dictionary: "klingon"},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.Flags.Lists(words.DefaultNumLetters)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Package absurdle hosts games of wordle without a fixed answer, like Absurdle.
+// The host scores each guess so that the most answers are still possible.
+package absurdle
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+// Host is an adversary that avoids giving away the answer for as long as it can
+type Host struct {
+	// Possible are the answers that agree with every score the host has given
+	Possible   words.Words
+	history    result.History
+	numLetters int
+}
+
+// New creates a host that can pick any of the answers, which are words of the alphabet that are numLetters long
+func New(answers words.Words, numLetters int, alphabet *char_set.Alphabet) *Host {
+	h := Host{
+		Possible:   *answers.Copy(),
+		history:    result.NewHistory(alphabet),
+		numLetters: numLetters,
+	}
+	return &h
+}
+
+// Score gives the guess the score that leaves the most possible answers.
+// When scores leave the same number of answers, the one that shows the fewest correct and almost correct letters is given.
+func (h *Host) Score(g guess.Guess) (score.Score, error) {
+	switch {
+	case h.Solved():
+		return "", errors.New("the answer was already guessed")
+	case len(h.Possible) == 0:
+		return "", errors.New("no answers to score the guess with")
+	}
+	if err := g.Validate(nil, h.numLetters); err != nil {
+		return "", err
+	}
+	if err := h.history.ValidateLetters(g); err != nil {
+		return "", err
+	}
+	counts := make(map[score.Score]int)
+	for w := range h.Possible {
+		counts[score.Compute(string(g), w)]++
+	}
+	var best score.Score
+	for s, n := range counts {
+		if len(best) == 0 || scoreLess(s, n, best, counts[best]) < 0 {
+			best = s
+		}
+	}
+	r := result.Result{
+		Guess: g,
+		Score: best,
+	}
+	if err := h.history.AddResult(r, &h.Possible); err != nil {
+		return "", fmt.Errorf("scoring guess: %w", err) // a computed score should never contradict the earlier ones
+	}
+	return best, nil
+}
+
+// scoreLess orders scores that leave the most answers first, then the ones that show the fewest letters
+func scoreLess(a score.Score, aCount int, b score.Score, bCount int) int {
+	if aCount != bCount {
+		return cmp.Compare(bCount, aCount)
+	}
+	if aShown, bShown := shown(a), shown(b); aShown != bShown {
+		return cmp.Compare(aShown, bShown)
+	}
+	return cmp.Compare(a, b)
+}
+
+// shown is how much the score tells about the answer: two for each correct letter and one for each almost correct letter
+func shown(s score.Score) int {
+	return 2*strings.Count(string(s), "c") + strings.Count(string(s), "a")
+}
+
+// Results are the guesses and the scores the host gave them
+func (h Host) Results() result.Results {
+	return h.history.Results()
+}
+
+// Solved determines if the last guess was scored as all correct
+func (h Host) Solved() bool {
+	rs := h.history.Results()
+	return len(rs) != 0 && rs[len(rs)-1].Score == score.AllCorrect(h.numLetters)
+}
+
+// Play scans guesses from the ReadWriter and writes their scores until the answer is guessed.
+// The guesses must be in the list of guesses.
+func (h *Host) Play(rw io.ReadWriter, guesses words.Words) error {
+	for !h.Solved() {
+		g, err := guess.Scan(rw, guesses, h.numLetters, h.history.ValidateLetters)
+		if err != nil {
+			return err
+		}
+		s, err := h.Score(*g)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(rw, "score: %v\n", s)
+		if !h.Solved() {
+			fmt.Fprintf(rw, "possible answers left: %v\n", len(h.Possible))
+		}
+	}
+	fmt.Fprintf(rw, "solved in %v guesses\n", len(h.history.Results()))
+	return nil
+}
@@ -0,0 +1,191 @@
+package absurdle
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+func newWords(list ...string) words.Words {
+	m := make(words.Words, len(list))
+	for _, w := range list {
+		m[w] = struct{}{}
+	}
+	return m
+}
+
+func TestNew(t *testing.T) {
+	answers := newWords("crane", "slate")
+	h := New(answers, 5, nil)
+	if _, err := h.Score("crane"); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	if len(answers) != 2 {
+		t.Errorf("answers should not be trimmed by the host: %v", answers)
+	}
+}
+
+func TestScore(t *testing.T) {
+	patches := newWords("batch", "catch", "hatch", "latch", "match", "patch", "watch")
+	tests := []struct {
+		name         string
+		answers      words.Words
+		guesses      []guess.Guess
+		want         result.Results
+		wantPossible words.Words
+		wantSolved   bool
+		wantErr      bool
+	}{
+		{
+			name:         "largest group",
+			answers:      patches,
+			guesses:      []guess.Guess{"crane"},
+			want:         result.Results{{Guess: "crane", Score: "anann"}},
+			wantPossible: newWords("batch", "hatch", "latch", "match", "patch", "watch"),
+		},
+		{
+			name:    "not the answer while others are possible",
+			answers: patches,
+			guesses: []guess.Guess{"crane", "batch"},
+			want: result.Results{
+				{Guess: "crane", Score: "anann"},
+				{Guess: "batch", Score: "ncccc"},
+			},
+			wantPossible: newWords("hatch", "latch", "match", "patch", "watch"),
+		},
+		{
+			name:         "tie shows fewest letters",
+			answers:      newWords("crane", "slate"),
+			guesses:      []guess.Guess{"forte"},
+			want:         result.Results{{Guess: "forte", Score: "nnanc"}},
+			wantPossible: newWords("crane"),
+		},
+		{
+			name:    "solved",
+			answers: newWords("crane", "slate"),
+			guesses: []guess.Guess{"forte", "crane"},
+			want: result.Results{
+				{Guess: "forte", Score: "nnanc"},
+				{Guess: "crane", Score: "ccccc"},
+			},
+			wantPossible: newWords("crane"),
+			wantSolved:   true,
+		},
+		{
+			name:    "guess after solved",
+			answers: newWords("crane"),
+			guesses: []guess.Guess{"crane", "slate"},
+			wantErr: true,
+		},
+		{
+			name:    "no answers",
+			answers: nil,
+			guesses: []guess.Guess{"crane"},
+			wantErr: true,
+		},
+		{
+			name:    "short guess",
+			answers: patches,
+			guesses: []guess.Guess{"cat"},
+			wantErr: true,
+		},
+		{
+			name:    "guess not in alphabet",
+			answers: patches,
+			guesses: []guess.Guess{"cr4ne"},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := New(test.answers, 5, nil)
+			var err error
+			for _, g := range test.guesses {
+				if _, err = h.Score(g); err != nil {
+					break
+				}
+			}
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, h.Results()):
+				t.Errorf("results not equal:\nwanted: %v\ngot:    %v", test.want, h.Results())
+			case !reflect.DeepEqual(test.wantPossible, h.Possible):
+				t.Errorf("possible words not equal:\nwanted: %v\ngot:    %v", test.wantPossible, h.Possible)
+			case test.wantSolved != h.Solved():
+				t.Errorf("wanted solved to be %v", test.wantSolved)
+			}
+		})
+	}
+}
+
+func TestScoreLess(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      score.Score
+		aCount int
+		b      score.Score
+		bCount int
+		want   int
+	}{
+		{"more words", "ccnnn", 3, "nnnnn", 2, -1},
+		{"fewer words", "nnnnn", 2, "ccnnn", 3, 1},
+		{"fewer correct", "aaann", 2, "cnnnn", 2, 1},
+		{"fewer almost", "annnn", 2, "aannn", 2, -1},
+		{"same letters shown", "anann", 2, "aannn", 2, 1},
+		{"same", "nnnnn", 2, "nnnnn", 2, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := scoreLess(test.a, test.aCount, test.b, test.bCount); test.want != got {
+				t.Errorf("wanted %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPlay(t *testing.T) {
+	h := New(newWords("forte", "forth"), 5, nil)
+	var buf strings.Builder
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("forts xyzzy forth forte")),
+		Writer: bufio.NewWriter(&buf),
+	}
+	err := h.Play(rw, newWords("forte", "forth", "forts"))
+	rw.Flush()
+	wantOut := "Enter guess (5 letters): score: ccccn\n" +
+		"possible answers left: 2\n" +
+		"Enter guess (5 letters): xyzzy is not a word\n" +
+		"Enter guess (5 letters): score: ccccn\n" +
+		"possible answers left: 1\n" +
+		"Enter guess (5 letters): score: ccccc\n" +
+		"solved in 3 guesses\n"
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case wantOut != buf.String():
+		t.Errorf("outputs not equal:\nwanted: %q\ngot:    %q", wantOut, buf.String())
+	}
+}
+
+func TestPlayEOF(t *testing.T) {
+	h := New(newWords("forte", "forth"), 5, nil)
+	var buf strings.Builder
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("forts")),
+		Writer: bufio.NewWriter(&buf),
+	}
+	if err := h.Play(rw, nil); err == nil {
+		t.Errorf("wanted error when input ends before the answer is guessed")
+	}
+}
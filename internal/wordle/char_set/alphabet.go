@@ -0,0 +1,144 @@
+package char_set
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Alphabet is the lowercase letters that the words of a language are made of, in order.
+// A nil Alphabet is the English alphabet.
+type Alphabet struct {
+	name    string
+	letters []rune
+	indexes map[rune]int
+}
+
+// maxAlphabetLength is the number of letters that fit in the bit field of a CharSet
+const maxAlphabetLength = 64
+
+var (
+	// English is the alphabet of the original game: a-z
+	English = mustAlphabet("english", "abcdefghijklmnopqrstuvwxyz")
+	// Spanish adds ñ after n
+	Spanish = mustAlphabet("spanish", "abcdefghijklmnñopqrstuvwxyz")
+	// German adds umlauts and the sharp s
+	German = mustAlphabet("german", "abcdefghijklmnopqrstuvwxyzäöüß")
+	// Portuguese adds accented vowels and the c-cedilla
+	Portuguese = mustAlphabet("portuguese", "abcdefghijklmnopqrstuvwxyzáàâãçéêíóôõú")
+)
+
+// alphabets are the predefined alphabets, by name
+var alphabets = []*Alphabet{English, Spanish, German, Portuguese}
+
+// NewAlphabet creates an alphabet from its letters, which must be unique lowercase letters.
+func NewAlphabet(name, letters string) (*Alphabet, error) {
+	a := Alphabet{
+		name:    name,
+		indexes: make(map[rune]int, len(letters)),
+	}
+	for _, ch := range letters {
+		if _, ok := a.indexes[ch]; ok {
+			return nil, fmt.Errorf("alphabet %v has %q more than once", name, ch)
+		}
+		if !unicode.IsLetter(ch) || unicode.ToLower(ch) != ch {
+			return nil, fmt.Errorf("alphabet %v has %q, which is not a lowercase letter", name, ch)
+		}
+		a.indexes[ch] = len(a.letters)
+		a.letters = append(a.letters, ch)
+	}
+	switch n := len(a.letters); {
+	case n == 0:
+		return nil, fmt.Errorf("alphabet %v has no letters", name)
+	case n > maxAlphabetLength:
+		return nil, fmt.Errorf("alphabet %v has %v letters, but can have at most %v", name, n, maxAlphabetLength)
+	}
+	return &a, nil
+}
+
+// mustAlphabet creates one of the predefined alphabets
+func mustAlphabet(name, letters string) *Alphabet {
+	a, err := NewAlphabet(name, letters)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Alphabets returns the predefined alphabets
+func Alphabets() []*Alphabet {
+	return append([]*Alphabet(nil), alphabets...)
+}
+
+// AlphabetNamed finds the predefined alphabet with the name.  The English alphabet is used if the name is empty.
+func AlphabetNamed(name string) (*Alphabet, error) {
+	if len(name) == 0 {
+		return English, nil
+	}
+	for _, a := range alphabets {
+		if strings.EqualFold(a.name, name) {
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown alphabet: %q", name)
+}
+
+// orDefault is the alphabet, or the English alphabet if it is nil
+func (a *Alphabet) orDefault() *Alphabet {
+	if a == nil {
+		return English
+	}
+	return a
+}
+
+// Name identifies the alphabet
+func (a *Alphabet) Name() string {
+	return a.orDefault().name
+}
+
+// Has determines if the letter is in the alphabet
+func (a *Alphabet) Has(ch rune) bool {
+	_, ok := a.orDefault().indexes[ch]
+	return ok
+}
+
+// HasAll determines if every letter of the word is in the alphabet
+func (a *Alphabet) HasAll(w string) bool {
+	for _, ch := range w {
+		if !a.Has(ch) {
+			return false
+		}
+	}
+	return true
+}
+
+// Letters returns the letters of the alphabet, in order
+func (a *Alphabet) Letters() []rune {
+	return append([]rune(nil), a.orDefault().letters...)
+}
+
+// String is the letters of the alphabet
+func (a *Alphabet) String() string {
+	return string(a.orDefault().letters)
+}
+
+// Range is a short description of the letters of the alphabet: "a-z" for English
+func (a *Alphabet) Range() string {
+	if a.orDefault() == English {
+		return "a-z"
+	}
+	return a.String()
+}
+
+// Pattern is a regular expression character class that matches one letter of the alphabet
+func (a *Alphabet) Pattern() string {
+	return "[" + a.Range() + "]"
+}
+
+// NewCharSet creates an empty set of the letters of the alphabet
+func (a *Alphabet) NewCharSet() CharSet {
+	if a.orDefault() == English {
+		return CharSet{} // the zero value stores English letters
+	}
+	return CharSet{alphabet: a}
+}
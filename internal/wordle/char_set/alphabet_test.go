@@ -0,0 +1,89 @@
+package char_set
+
+import "testing"
+
+func TestNewAlphabet(t *testing.T) {
+	tests := []struct {
+		name    string
+		letters string
+		wantOk  bool
+	}{
+		{"empty", "", false},
+		{"duplicate", "abca", false},
+		{"uppercase", "abC", false},
+		{"digit", "ab1", false},
+		{"greek", "αβγδε", true},
+		{"too long", English.String() + "αβγδεζηθικλμνξοπρστυφχψω" + "абвгдеёжзийклмнопрстуфхцчшщъыьэюя", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewAlphabet(test.name, test.letters)
+			switch {
+			case err != nil:
+				if test.wantOk {
+					t.Errorf("unwanted error: %v", err)
+				}
+			case !test.wantOk:
+				t.Errorf("wanted error")
+			}
+		})
+	}
+}
+
+func TestAlphabetNamed(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   *Alphabet
+		wantOk bool
+	}{
+		{"", English, true},
+		{"english", English, true},
+		{"Spanish", Spanish, true},
+		{"klingon", nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := AlphabetNamed(test.name)
+			switch {
+			case err != nil:
+				if test.wantOk {
+					t.Errorf("unwanted error: %v", err)
+				}
+			case !test.wantOk:
+				t.Errorf("wanted error")
+			case test.want != got:
+				t.Errorf("wanted %v, got %v", test.want.Name(), got.Name())
+			}
+		})
+	}
+}
+
+func TestAlphabetPattern(t *testing.T) {
+	var a *Alphabet
+	if want, got := "[a-z]", a.Pattern(); want != got {
+		t.Errorf("nil alphabet: wanted %q, got %q", want, got)
+	}
+	if want, got := "[abcdefghijklmnñopqrstuvwxyz]", Spanish.Pattern(); want != got {
+		t.Errorf("spanish alphabet: wanted %q, got %q", want, got)
+	}
+}
+
+func TestAlphabetCharSet(t *testing.T) {
+	cs := German.NewCharSet()
+	cs.AddAll("süß")
+	if want, got := "[süß]", cs.String(); want != got {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+	if cs.Has('ñ') {
+		t.Errorf("ñ is not in the german alphabet")
+	}
+	for _, ch := range German.Letters() {
+		if ch != 's' && ch != 'ü' && ch != 'ß' && cs.AddWouldFill(ch) {
+			t.Errorf("adding %c would not fill the set", ch)
+		}
+	}
+	var english CharSet
+	if english != English.NewCharSet() {
+		t.Errorf("wanted the zero char set to be the empty english char set")
+	}
+}
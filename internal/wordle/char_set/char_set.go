@@ -2,18 +2,23 @@ package char_set
 
 import (
 	"fmt"
+	"math/bits"
 	"strings"
 )
 
-// CharSet is a bit field that stores the letters a-z
-type CharSet uint32
+// CharSet is a bit field that stores the letters of an alphabet.
+// The zero value stores the letters a-z.  Use Alphabet.NewCharSet to store the letters of other alphabets.
+type CharSet struct {
+	alphabet *Alphabet
+	bits     uint64
+}
 
-// Add includes the character to the set.  Panics if the character is not in a-z
+// Add includes the character to the set.  Panics if the character is not in the alphabet
 func (cs *CharSet) Add(ch rune) {
 	if !cs.valid(ch) {
-		panic(fmt.Errorf("%c is not in a-z", ch))
+		panic(fmt.Errorf("%c is not in %v", ch, cs.alphabet.Name()))
 	}
-	*cs |= cs.singleton(ch)
+	cs.bits |= cs.singleton(ch)
 }
 
 func (cs *CharSet) AddAll(s string) {
@@ -24,9 +29,9 @@ func (cs *CharSet) AddAll(s string) {
 
 func (cs *CharSet) Remove(ch rune) {
 	if !cs.valid(ch) {
-		panic(fmt.Errorf("%c is not in a-z", ch))
+		panic(fmt.Errorf("%c is not in %v", ch, cs.alphabet.Name()))
 	}
-	*cs &^= cs.singleton(ch)
+	cs.bits &^= cs.singleton(ch)
 }
 
 func (cs *CharSet) RemoveAll(s string) {
@@ -40,22 +45,29 @@ func (cs CharSet) Has(ch rune) bool {
 	if !cs.valid(ch) {
 		return false
 	}
-	return (cs & cs.singleton(ch)) != 0
+	return (cs.bits & cs.singleton(ch)) != 0
+}
+
+// IsEmpty determines if the set has no characters
+func (cs CharSet) IsEmpty() bool {
+	return cs.bits == 0
 }
 
-// AddWouldFill determines if the charset is filled with the letters a-z
+// AddWouldFill determines if the charset is filled with the letters of the alphabet
 func (cs CharSet) AddWouldFill(ch rune) bool {
 	if !cs.valid(ch) {
 		return false
 	}
-	return cs|cs.singleton(ch) == cs.singleton('z'+1)-1
+	n := len(cs.alphabet.orDefault().letters)
+	all := uint64(1)<<n - 1 // n is at most 64, shifting by 64 is zero
+	return cs.bits|cs.singleton(ch) == all
 }
 
-// String creates a string of the characters in the set, in ascending order
+// String creates a string of the characters in the set, in alphabetical order
 func (cs CharSet) String() string {
 	var b strings.Builder
 	b.WriteRune('[')
-	for ch := rune('a'); ch <= 'z'; ch++ {
+	for _, ch := range cs.alphabet.orDefault().letters {
 		if cs.Has(ch) {
 			b.WriteRune(ch)
 		}
@@ -64,22 +76,16 @@ func (cs CharSet) String() string {
 	return b.String()
 }
 
-// valid determines if the byte can be used in the charSet, if it is a-z
-func (CharSet) valid(ch rune) bool {
-	return 'a' <= ch && ch <= 'z'
+// valid determines if the character can be used in the charSet, if it is in the alphabet
+func (cs CharSet) valid(ch rune) bool {
+	return cs.alphabet.Has(ch)
 }
 
-// singleton creates a singleton charSet from the character
-func (CharSet) singleton(ch rune) CharSet {
-	return 1 << (ch - 'a')
+// singleton creates the bit of the character
+func (cs CharSet) singleton(ch rune) uint64 {
+	return 1 << cs.alphabet.orDefault().indexes[ch]
 }
 
 func (cs CharSet) Length() int {
-	n := 0
-	for ch := rune('a'); ch <= 'z'; ch++ {
-		if cs.Has(ch) {
-			n++
-		}
-	}
-	return n
+	return bits.OnesCount64(cs.bits)
 }
@@ -3,41 +3,160 @@ package cheater
 import (
 	"fmt"
 	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/letter_stats"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/multi_board"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
 	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
 )
 
-func RunWordleCheater(rw io.ReadWriter, wordsText string) error {
-	const numLetters = 5
-	allWords, err := words.New(wordsText)
+// Config customizes the wordle cheater
+type Config struct {
+	// AnswersText is the words that are likely answers.  All the words can be answers if it is empty.
+	AnswersText string
+	// FrequenciesText has counts of how often words are used, used to rank the possible words by how likely they are.
+	// All the possible words are equally likely if it is empty.
+	FrequenciesText string
+	// NumLetters is the length of the words, the default length is used if it is zero
+	NumLetters int
+	// SuggestionCount is the number of recommended guesses to show after each turn
+	SuggestionCount int
+	// SuggestFromAll ranks every word as a guess, not just the possible words
+	SuggestFromAll bool
+	// SuggestStrategy ranks the suggested guesses, by entropy if it is empty
+	SuggestStrategy recommend.Strategy
+	// HardMode requires guesses to use the correct and almost correct letters of previous results
+	HardMode bool
+	// Boards is the number of answers that are solved with the same guesses.  A single board is used if it is not positive.
+	Boards int
+	// Resume are the results of a saved game to continue
+	Resume result.Results
+	// Save is called with all the results after each turn so the game can be resumed later
+	Save func(rs result.Results) error
+	// Share is a grid shared from a game with the same answer, used to rule out answers that could not have produced it
+	Share *score.Share
+	// WhyNot prompts for words to explain why they can not be the answer after each turn
+	WhyNot bool
+	// PastAnswersText has the date and answer of earlier puzzles on each line.  The answers used before Date are not possible.
+	PastAnswersText string
+	// Date is the day of the puzzle, today if it is zero
+	Date time.Time
+	// ShowStats shows a heatmap of how common each letter is at each position of the possible words after each turn
+	ShowStats bool
+	// Alphabet is the letters that guesses are made of, English if nil
+	Alphabet *char_set.Alphabet
+	// Constraints are facts about the answer that are known without the guesses that showed them
+	Constraints result.Constraints
+	// Tree has the guesses to make after each score.  Its guess is shown instead of searching for suggestions while the guesses follow it.
+	Tree *decision_tree.Tree
+}
+
+func RunWordleCheater(rw io.ReadWriter, l *words.Lexicon, cfg Config) error {
+	numLetters := cfg.NumLetters
+	if numLetters == 0 {
+		numLetters = words.DefaultNumLetters
+	}
+	lists, err := l.Lists(words.NewLexicon(cfg.AnswersText), numLetters)
 	if err != nil {
 		return fmt.Errorf("loading words: %v", err)
 	}
-	availableWords := allWords.Copy()
+	allWords := &lists.Guesses
+	availableWords := lists.Answers.Copy()
+	var priors *words.Frequencies
+	if len(cfg.FrequenciesText) != 0 {
+		if priors, err = words.ParseFrequencies(cfg.FrequenciesText, numLetters); err != nil {
+			return fmt.Errorf("loading word frequencies: %v", err)
+		}
+	}
 
 	fmt.Fprintf(rw, "Running wordle-cheater\n")
 	fmt.Fprintf(rw, " * Guesses and scores are %v letters long\n", numLetters)
+	fmt.Fprintf(rw, " * Guesses are made of the letters %v\n", cfg.Alphabet.Range())
 	fmt.Fprintf(rw, " * Scores are only made of the following letters:\n")
 	fmt.Fprintf(rw, "   C - if a letter is in the word and in the correct location\n")
 	fmt.Fprintf(rw, "   A - if a letter is in the word, but in the wrong location\n")
 	fmt.Fprintf(rw, "   N - if a letter is not in the word\n")
+	fmt.Fprintf(rw, "   Scores can also be written in other notations: %v\n", otherNotations())
+	var pastAnswers words.PastAnswers
+	if len(cfg.PastAnswersText) != 0 {
+		if pastAnswers, err = words.ParsePastAnswers(cfg.PastAnswersText); err != nil {
+			return fmt.Errorf("loading past answers: %v", err)
+		}
+		if cfg.Date.IsZero() {
+			cfg.Date = time.Now()
+		}
+		n := pastAnswers.Remove(availableWords, cfg.Date)
+		fmt.Fprintf(rw, " * Past answers are not possible: %v were used before %v\n", n, cfg.Date.Format(time.DateOnly))
+	}
+	if cfg.HardMode {
+		fmt.Fprintf(rw, " * Hard mode: guesses must use the correct and almost correct letters of previous scores\n")
+	}
+	if cfg.Boards > 1 {
+		if len(cfg.Resume) != 0 || cfg.Share != nil || pastAnswers != nil || !cfg.Constraints.IsEmpty() || cfg.Tree != nil {
+			return fmt.Errorf("resuming, shared grids, past answers, constraints and decision trees are only supported for a single board")
+		}
+		fmt.Fprintf(rw, " * Each guess is scored on every unsolved board, of %v\n", cfg.Boards)
+		fmt.Fprintf(rw, "The app runs until the correct word is found on every board from guesses with only correct letters.\n\n")
+		return runMultiBoard(rw, cfg, *lists, priors, numLetters)
+	}
+	if cfg.Tree != nil {
+		if n := cfg.Tree.NumLetters(); n != numLetters {
+			return fmt.Errorf("the decision tree has guesses that are %v letters long, wanted %v", n, numLetters)
+		}
+		fmt.Fprintf(rw, " * Guesses are looked up in the decision tree while the guesses follow it\n")
+	}
 	fmt.Fprintf(rw, "The app runs until the correct word is found from a guess with only correct letters.\n\n")
 
-	var h result.History
+	h := result.NewHistory(cfg.Alphabet)
+	if !cfg.Constraints.IsEmpty() {
+		if err := h.AddConstraints(cfg.Constraints, numLetters, availableWords); err != nil {
+			return fmt.Errorf("adding constraints: %w", err)
+		}
+		c := cfg.Constraints
+		fmt.Fprintf(rw, "added constraints: correct %q, almost %q, excluded %q\n", c.Correct, c.Almost, c.Excluded)
+	}
+	for i, r := range cfg.Resume {
+		if utf8.RuneCountInString(string(r.Guess)) != numLetters {
+			return fmt.Errorf("resuming result %v: guess must be %v letters long", i+1, numLetters)
+		}
+		if err := h.AddResult(r, availableWords); err != nil {
+			return fmt.Errorf("resuming result %v: %w", i+1, err)
+		}
+		fmt.Fprintf(rw, "resumed guess %v: %v %v\n", i+1, r.Guess, r.Score)
+	}
+	if cfg.Share != nil {
+		if err := filterShare(rw, *cfg.Share, availableWords, *allWords, numLetters); err != nil {
+			return err
+		}
+	}
+	if cfg.Tree != nil {
+		writeTreeGuess(rw, *cfg.Tree, h.Results())
+	}
 	for {
-		g, err := guess.Scan(rw, *allWords)
+		validators := []func(guess.Guess) error{h.ValidateLetters}
+		if cfg.HardMode {
+			validators = append(validators, h.ValidateHardMode)
+		}
+		g, err := guess.Scan(rw, *allWords, numLetters, validators...)
 		if err != nil {
 			return err
 		}
 
-		s, err := score.Scan(rw)
+		s, err := score.Scan(rw, numLetters)
 		if err != nil {
 			return err
 		}
-		if *s == score.AllCorrect {
+		if *s == score.AllCorrect(numLetters) {
 			return nil
 		}
 
@@ -45,10 +164,197 @@ func RunWordleCheater(rw io.ReadWriter, wordsText string) error {
 			Guess: *g,
 			Score: *s,
 		}
-		h.AddResult(r, availableWords)
+		if err := h.AddResult(r, availableWords); err != nil {
+			fmt.Fprintf(rw, "%v\n", err)
+			continue
+		}
+
+		if cfg.Save != nil {
+			if err := cfg.Save(h.Results()); err != nil {
+				return fmt.Errorf("saving results: %w", err)
+			}
+		}
+
+		if err := availableWords.ScanShowPossible(rw, priors); err != nil {
+			return err
+		}
+		if cfg.ShowStats {
+			letter_stats.New(*availableWords).WriteHeatmap(rw)
+		}
+
+		if cfg.Share != nil && len(*availableWords) == 1 {
+			for answer := range *availableWords {
+				writeSharePaths(rw, *cfg.Share, answer, *allWords)
+			}
+		}
+
+		if cfg.WhyNot {
+			if err := scanWhyNot(rw, h, *lists, pastAnswers, cfg.Date); err != nil {
+				return err
+			}
+		}
+
+		if cfg.Tree != nil && writeTreeGuess(rw, *cfg.Tree, h.Results()) {
+			continue // the guess is already known
+		}
+
+		if cfg.SuggestionCount > 0 {
+			guesses := allWords
+			if cfg.HardMode {
+				guesses = allWords.Copy()
+				h.FilterHardMode(guesses)
+			}
+			showSuggestions(rw, cfg, priors, *availableWords, *guesses)
+		}
+	}
+}
+
+// otherNotations describes the score notations other than the canonical letters
+func otherNotations() string {
+	var others []string
+	for _, n := range score.Notations() {
+		if n.Name != score.Letters.Name {
+			others = append(others, fmt.Sprintf("%v (%v)", n, n.Name))
+		}
+	}
+	return strings.Join(others, ", ")
+}
+
+// scanWhyNot prompts for words to explain why they can not be the answer until a dash is entered
+func scanWhyNot(rw io.ReadWriter, h result.History, lists words.Lists, pastAnswers words.PastAnswers, date time.Time) error {
+	for {
+		fmt.Fprintf(rw, "why not (- to continue): ")
+		var w string
+		if _, err := fmt.Fscan(rw, &w); err != nil {
+			return fmt.Errorf("scanning word: %v", err)
+		}
+		w = strings.ToLower(w)
+		if w == "-" {
+			return nil
+		}
+		if _, ok := lists.Guesses[w]; !ok {
+			fmt.Fprintf(rw, "%v is not in the word list\n", w)
+			continue
+		}
+		if _, ok := lists.Answers[w]; !ok {
+			fmt.Fprintf(rw, "%v is not in the answer list\n", w)
+			continue
+		}
+		if used, ok := pastAnswers.UsedBefore(w, date); ok {
+			fmt.Fprintf(rw, "%v was already the answer on %v\n", w, used.Format(time.DateOnly))
+			continue
+		}
+		reasons := h.Explain(w)
+		if len(reasons) == 0 {
+			fmt.Fprintf(rw, "%v is still possible\n", w)
+			continue
+		}
+		fmt.Fprintf(rw, "%v is not possible: %v\n", w, strings.Join(reasons, ", "))
+	}
+}
+
+// filterShare removes the words that could not be the answer of the shared grid
+func filterShare(w io.Writer, sh score.Share, availableWords *words.Words, allWords words.Words, numLetters int) error {
+	if len(sh.Scores[0]) != numLetters {
+		return fmt.Errorf("shared grid rows must be %v letters long", numLetters)
+	}
+	guesses := slices.Sorted(maps.Keys(allWords))
+	for answer := range *availableWords {
+		if !sh.Allows(answer, guesses) {
+			delete(*availableWords, answer)
+		}
+	}
+	fmt.Fprintf(w, "the shared grid allows %v answers\n", len(*availableWords))
+	return nil
+}
+
+// writeSharePaths writes the guesses that could have been made for each row of the shared grid
+func writeSharePaths(w io.Writer, sh score.Share, answer string, allWords words.Words) {
+	const maxPathWords = 10
+	paths := sh.Paths(answer, slices.Sorted(maps.Keys(allWords)))
+	for i, path := range paths {
+		n := len(path)
+		if n > maxPathWords {
+			path = append(path[:maxPathWords], "...")
+		}
+		fmt.Fprintf(w, "shared guess %v could have been (%v): %v\n", i+1, n, strings.Join(path, ","))
+	}
+}
 
-		if err := availableWords.ScanShowPossible(rw); err != nil {
+// runMultiBoard scans guesses and a score for each unsolved board until all the boards are solved
+func runMultiBoard(rw io.ReadWriter, cfg Config, lists words.Lists, priors *words.Frequencies, numLetters int) error {
+	allWords := lists.Guesses
+	bs, err := multi_board.New(cfg.Boards, lists.Answers, cfg.Alphabet)
+	if err != nil {
+		return err
+	}
+	for !bs.Solved() {
+		g, err := guess.Scan(rw, allWords, numLetters)
+		if err != nil {
 			return err
 		}
+
+		unsolved := bs.Unsolved()
+		scores := make([]score.Score, len(unsolved))
+		for i, j := range unsolved {
+			fmt.Fprintf(rw, "Board %v: ", j+1)
+			s, err := score.Scan(rw, numLetters)
+			if err != nil {
+				return err
+			}
+			scores[i] = *s
+		}
+		if err := bs.AddGuess(*g, scores); err != nil {
+			fmt.Fprintf(rw, "%v\n", err)
+			continue
+		}
+
+		for _, j := range bs.Unsolved() {
+			possible := slices.Sorted(maps.Keys(bs[j].Possible))
+			fmt.Fprintf(rw, "board %v possible words (%v): %v\n", j+1, len(possible), strings.Join(possible, ","))
+		}
+
+		if cfg.SuggestionCount > 0 && !bs.Solved() {
+			r := recommend.Recommender{
+				Strategy: cfg.SuggestStrategy,
+				FromAll:  cfg.SuggestFromAll,
+				Count:    cfg.SuggestionCount,
+				Priors:   priors,
+			}
+			writeRecommendations(rw, r.RankBoards(bs.Possibles(), allWords))
+		}
+	}
+	return nil
+}
+
+// showSuggestions writes the best guesses to make next
+func showSuggestions(w io.Writer, cfg Config, priors *words.Frequencies, possible, guesses words.Words) {
+	r := recommend.Recommender{
+		Strategy: cfg.SuggestStrategy,
+		FromAll:  cfg.SuggestFromAll,
+		Count:    cfg.SuggestionCount,
+		Priors:   priors,
+	}
+	recommendations := r.Rank(possible, guesses)
+	writeRecommendations(w, recommendations)
+}
+
+// writeTreeGuess writes the guess of the tree to make after the results, returning false if the tree does not have one
+func writeTreeGuess(w io.Writer, t decision_tree.Tree, rs result.Results) bool {
+	g, err := t.NextGuess(rs)
+	if err != nil {
+		fmt.Fprintf(w, "no decision tree guess: %v\n", err)
+		return false
+	}
+	fmt.Fprintf(w, "decision tree guess: %v\n", g)
+	return true
+}
+
+// writeRecommendations writes the recommended guesses on a line, with the most words each can leave and how many scores it can get
+func writeRecommendations(w io.Writer, recommendations []recommend.Recommendation) {
+	fmt.Fprintf(w, "suggested guesses:")
+	for _, rec := range recommendations {
+		fmt.Fprintf(w, " %v (%.2f bits, worst %v, %v outcomes)", rec.Guess, rec.Entropy, rec.Largest, rec.Outcomes)
 	}
+	fmt.Fprintln(w)
 }
@@ -2,15 +2,27 @@ package cheater
 
 import (
 	"bufio"
+	"fmt"
+	"io"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/decision_tree"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
 )
 
 func TestRunWordleCheater(t *testing.T) {
 	tests := []struct {
 		readTokens string
 		wordsText  string
-		wantErr    bool
+		Config
+		wantErr bool
 	}{
 		{
 			readTokens: "smart ccccc",
@@ -40,6 +52,27 @@ func TestRunWordleCheater(t *testing.T) {
 			readTokens: "guess nnnnn",
 			wantErr:    true, // EOF scanShowPossible
 		},
+		{
+			readTokens: "dummy nnnnn n smart ccccc",
+			wordsText:  "smart",
+			Config:     Config{SuggestionCount: 3, SuggestFromAll: true},
+		},
+		{
+			readTokens: "card nnnn n word cccc",
+			wordsText:  "word card",
+			Config:     Config{NumLetters: 4},
+		},
+		{
+			readTokens: "bathe ncccc n lathe ccccc",
+			wordsText:  "bathe lathe tithe",
+			Config:     Config{HardMode: true, SuggestionCount: 1, SuggestFromAll: true},
+		},
+		{
+			readTokens: "words",
+			wordsText:  "words",
+			Config:     Config{NumLetters: -1},
+			wantErr:    true,
+		},
 		{
 			readTokens: "apple ncccc n berry ncccc",
 			wantErr:    true, // too many required letters
@@ -51,7 +84,7 @@ func TestRunWordleCheater(t *testing.T) {
 			Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
 			Writer: bufio.NewWriter(&buf),
 		}
-		gotErr := RunWordleCheater(rw, test.wordsText)
+		gotErr := RunWordleCheater(rw, words.NewLexicon(test.wordsText), test.Config)
 		switch {
 		case test.wantErr:
 			if gotErr == nil {
@@ -62,3 +95,679 @@ func TestRunWordleCheater(t *testing.T) {
 		}
 	}
 }
+
+func TestRunWordleCheaterNotation(t *testing.T) {
+	var buf strings.Builder
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("bathe 02222 n lathe +++++")),
+		Writer: bufio.NewWriter(&buf),
+	}
+	err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe"), Config{})
+	rw.Flush()
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case !strings.Contains(buf.String(), "other notations: 210 (digits), gy- (colors), +?. (marks), 🟩🟨⬛ (emoji)\n"):
+		t.Errorf("wanted notations in output, got %q", buf.String())
+	case strings.Count(buf.String(), "Enter score: ") != 2:
+		t.Errorf("wanted both scores to be valid, got %q", buf.String())
+	}
+}
+
+func TestShowSuggestions(t *testing.T) {
+	spread := words.Words{"joint": {}, "mayor": {}, "stuff": {}, "extra": {}, "trade": {}, "known": {}, "night": {}}
+	tests := []struct {
+		name     string
+		strategy recommend.Strategy
+		possible words.Words
+		all      words.Words
+		want     string
+	}{
+		{
+			name:     "entropy",
+			possible: words.Words{"lathe": {}, "lithe": {}},
+			all:      words.Words{"lathe": {}, "lithe": {}, "blitz": {}},
+			want:     "suggested guesses: lathe (1.00 bits, worst 1, 2 outcomes)\n",
+		},
+		{
+			name:     "most entropy",
+			strategy: recommend.Entropy,
+			possible: spread,
+			all:      spread,
+			want:     "suggested guesses: extra (2.13 bits, worst 3, 5 outcomes)\n",
+		},
+		{
+			name:     "minimax",
+			strategy: recommend.Minimax,
+			possible: spread,
+			all:      spread,
+			want:     "suggested guesses: mayor (1.95 bits, worst 2, 4 outcomes)\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := Config{
+				SuggestionCount: 1,
+				SuggestStrategy: test.strategy,
+			}
+			var sb strings.Builder
+			showSuggestions(&sb, cfg, nil, test.possible, test.all)
+			if want, got := test.want, sb.String(); want != got {
+				t.Errorf("wanted %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterHardMode(t *testing.T) {
+	var buf strings.Builder
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("bathe ncccc n tithe lathe ccccc")),
+		Writer: bufio.NewWriter(&buf),
+	}
+	cfg := Config{
+		HardMode: true,
+	}
+	err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe"), cfg)
+	rw.Flush()
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case !strings.Contains(buf.String(), "2nd letter must be A\n"):
+		t.Errorf("wanted hard mode error in output, got %q", buf.String())
+	}
+}
+
+func TestRunWordleCheaterWhyNot(t *testing.T) {
+	tests := []struct {
+		name       string
+		readTokens string
+		want       string
+		wantErr    bool
+	}{
+		{"not possible", "bathe ncccc n TITHE - lathe ccccc", "tithe is not possible: position 2 must be 'a', needs one 'a'\n", false},
+		{"possible", "bathe ncccc n lathe - lathe ccccc", "lathe is still possible\n", false},
+		{"unknown word", "bathe ncccc n fjord - lathe ccccc", "fjord is not in the word list\n", false},
+		{"EOF", "bathe ncccc n lathe", "lathe is still possible\n", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe"), Config{WhyNot: true})
+			rw.Flush()
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			}
+			if !strings.Contains(buf.String(), test.want) {
+				t.Errorf("wanted %q in output, got %q", test.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterAnswers(t *testing.T) {
+	tests := []struct {
+		name       string
+		readTokens string
+		Config
+		want    string
+		wantErr bool
+	}{
+		{
+			name:       "possible answers",
+			readTokens: "tithe nnccc y lathe ccccc",
+			Config:     Config{AnswersText: "lathe tithe"},
+			want:       "remaining valid words: lathe\n",
+		},
+		{
+			name:       "not an answer",
+			readTokens: "tithe nnccc n bathe - lathe ccccc",
+			Config:     Config{AnswersText: "lathe tithe", WhyNot: true},
+			want:       "bathe is not in the answer list\n",
+		},
+		{
+			name:       "multiple boards",
+			readTokens: "tithe nnccc nnccc lathe ccccc ccccc",
+			Config:     Config{AnswersText: "lathe tithe", Boards: 2},
+			want:       "board 1 possible words (1): lathe\n",
+		},
+		{
+			name:       "uppercase answers",
+			readTokens: "lathe ccccc",
+			Config:     Config{AnswersText: "LATHE"},
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe"), test.Config)
+			rw.Flush()
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !strings.Contains(buf.String(), test.want):
+				t.Errorf("wanted %q in output, got %q", test.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterFrequencies(t *testing.T) {
+	tests := []struct {
+		name       string
+		readTokens string
+		Config
+		want    string
+		wantErr bool
+	}{
+		{
+			name:       "probable words",
+			readTokens: "crown nnnnn y lathe ccccc",
+			Config:     Config{FrequenciesText: "lathe 3\ntithe 1\n"},
+			want:       "remaining valid words: lathe (60%), bathe (20%), tithe (20%)\n",
+		},
+		{
+			name:       "suggestions",
+			readTokens: "crown nnnnn n lathe ccccc",
+			Config:     Config{FrequenciesText: "lathe 3\ntithe 1\n", SuggestionCount: 2},
+			want:       "suggested guesses: bathe (1.37 bits, worst 1, 3 outcomes) lathe (1.37 bits, worst 1, 3 outcomes)\n", // 1.58 bits each if the words were equally likely
+		},
+		{
+			name:       "invalid frequencies",
+			readTokens: "lathe ccccc",
+			Config:     Config{FrequenciesText: "lathe often"},
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("bathe crown lathe tithe"), test.Config)
+			rw.Flush()
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !strings.Contains(buf.String(), test.want):
+				t.Errorf("wanted %q in output, got %q", test.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterPastAnswers(t *testing.T) {
+	const pastAnswers = "2022-01-01 bathe\n2022-01-02 tithe\n"
+	tests := []struct {
+		name       string
+		readTokens string
+		Config
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:       "before date",
+			readTokens: "crown nnnnn y lathe ccccc",
+			Config:     Config{PastAnswersText: pastAnswers, Date: time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC)},
+			want: []string{
+				" * Past answers are not possible: 1 were used before 2022-01-02\n",
+				"remaining valid words: lathe,tithe\n",
+			},
+		},
+		{
+			name:       "today",
+			readTokens: "crown nnnnn y lathe ccccc",
+			Config:     Config{PastAnswersText: pastAnswers},
+			want:       []string{"remaining valid words: lathe\n"},
+		},
+		{
+			name:       "why not",
+			readTokens: "crown nnnnn n bathe - lathe ccccc",
+			Config:     Config{PastAnswersText: pastAnswers, WhyNot: true},
+			want:       []string{"bathe was already the answer on 2022-01-01\n"},
+		},
+		{
+			name:       "invalid past answers",
+			readTokens: "lathe ccccc",
+			Config:     Config{PastAnswersText: "yesterday bathe"},
+			wantErr:    true,
+		},
+		{
+			name:       "multiple boards",
+			readTokens: "lathe ccccc ccccc",
+			Config:     Config{PastAnswersText: pastAnswers, Boards: 2},
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("bathe crown lathe tithe"), test.Config)
+			rw.Flush()
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("wanted %q in output, got %q", want, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterShowStats(t *testing.T) {
+	var buf strings.Builder
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("crown nnnnn n lathe ccccc")),
+		Writer: bufio.NewWriter(&buf),
+	}
+	if err := RunWordleCheater(rw, words.NewLexicon("bathe crown lathe tithe"), Config{ShowStats: true}); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	rw.Flush()
+	want := "show possible words [Yn]: letters of 3 words (' ' is none, '@' is all):\n" +
+		"e 3 |    @|\n"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("wanted %q in output, got %q", want, buf.String())
+	}
+}
+
+func TestRunWordleCheaterAlphabet(t *testing.T) {
+	tests := []struct {
+		name     string
+		alphabet *char_set.Alphabet
+		input    string
+		want     string
+	}{
+		{"english", nil, "niños apple", "guess must be only the letters a-z\n"},
+		{"spanish", char_set.Spanish, "niños nncca y señor ccccc", "remaining valid words: señor\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.input)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			RunWordleCheater(rw, words.NewLexicon("cañón niños señor"), Config{Alphabet: test.alphabet})
+			rw.Flush()
+			if !strings.Contains(buf.String(), test.want) {
+				t.Errorf("wanted %q in output, got %q", test.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterConstraints(t *testing.T) {
+	tests := []struct {
+		name string
+		result.Constraints
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:        "constraints and guesses",
+			Constraints: result.Constraints{Correct: "?r???", Excluded: "t"},
+			input:       "brace ncccc y grace ccccc",
+			want:        "remaining valid words: grace\n",
+		},
+		{
+			name:        "bad constraints",
+			Constraints: result.Constraints{Correct: "?r??"},
+			wantErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.input)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("arose brace crane grace trace"), Config{Constraints: test.Constraints})
+			rw.Flush()
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !strings.Contains(buf.String(), test.want):
+				t.Errorf("wanted %q in output, got %q", test.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterTree(t *testing.T) {
+	tree, err := decision_tree.Parse([]byte("crane\n  accnc brace\n    ncccc grace\n"))
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	shortTree := decision_tree.Tree{Guess: "race"}
+	tests := []struct {
+		name string
+		Config
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "follows tree",
+			Config: Config{Tree: tree, SuggestionCount: 1},
+			input:  "crane accnc y brace ncccc y grace ccccc",
+			want: []string{
+				"decision tree guess: crane\n",
+				"decision tree guess: brace\n",
+				"decision tree guess: grace\n",
+			},
+		},
+		{
+			name:   "leaves tree",
+			Config: Config{Tree: tree, SuggestionCount: 1},
+			input:  "trace ncccc y brace ccccc",
+			want: []string{
+				"decision tree guess: crane\n",
+				"no decision tree guess: guess 1 is trace, but the tree guesses crane\n",
+				"suggested guesses: brace",
+			},
+		},
+		{
+			name:    "wrong length",
+			Config:  Config{Tree: &shortTree},
+			wantErr: true,
+		},
+		{
+			name:    "multiple boards",
+			Config:  Config{Tree: tree, Boards: 2},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.input)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("arose brace crane grace trace"), test.Config)
+			rw.Flush()
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("wanted %q in output, got %q", want, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterMultiBoard(t *testing.T) {
+	var buf strings.Builder
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("lathe ccccc ncccc cnccc lithe nnccc ccccc bathe ccccc")),
+		Writer: bufio.NewWriter(&buf),
+	}
+	cfg := Config{
+		Boards:          3,
+		SuggestionCount: 1,
+	}
+	err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe lithe"), cfg)
+	rw.Flush()
+	out := buf.String()
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case !strings.Contains(out, "Board 2: Enter score: Board 3: Enter score: "):
+		t.Errorf("wanted scores to be scanned for each board, got %q", out)
+	case !strings.Contains(out, "board 2 possible words (1): bathe\nboard 3 possible words (1): lithe\n"):
+		t.Errorf("wanted possible words for each unsolved board, got %q", out)
+	case !strings.Contains(out, "suggested guesses: bathe (0.00 bits, worst 1, 1 outcomes)\n"):
+		t.Errorf("wanted suggestions for all unsolved boards, got %q", out)
+	case strings.Count(out, "Board 1: ") != 1:
+		t.Errorf("wanted solved board to drop out, got %q", out)
+	}
+}
+
+func TestRunWordleCheaterMultiBoardEOF(t *testing.T) {
+	tests := []string{
+		"",
+		"lathe",
+		"lathe ccccc ncccc",
+	}
+	for i, readTokens := range tests {
+		rw := bufio.ReadWriter{
+			Reader: bufio.NewReader(strings.NewReader(readTokens)),
+			Writer: bufio.NewWriter(io.Discard),
+		}
+		if err := RunWordleCheater(rw, words.NewLexicon("bathe lathe"), Config{Boards: 2}); err == nil {
+			t.Errorf("test %v: wanted error", i)
+		}
+	}
+}
+
+func TestRunWordleCheaterContradiction(t *testing.T) {
+	tests := []struct {
+		name       string
+		readTokens string
+		Config
+	}{
+		{"single board", "boast ncccc n roast nnccc toast ccccc", Config{}},
+		{"multi board", "boast ccccc ncccc roast nnccc toast ccccc", Config{Boards: 2}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("boast roast toast"), test.Config)
+			rw.Flush()
+			switch {
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !strings.Contains(buf.String(), "result 2 contradicts earlier results for O: O is not the 2nd letter, but 2nd letter is O in an earlier result\n"):
+				t.Errorf("wanted contradiction in output, got %q", buf.String())
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterResume(t *testing.T) {
+	tests := []struct {
+		name       string
+		readTokens string
+		Config
+		wantSaved []result.Results
+		wantErr   bool
+	}{
+		{
+			name:       "resume and save",
+			readTokens: "tithe nnccc n lathe ccccc",
+			Config: Config{
+				Resume: result.Results{{Guess: "crumb", Score: "nnnnn"}},
+			},
+			wantSaved: []result.Results{
+				{{Guess: "crumb", Score: "nnnnn"}, {Guess: "tithe", Score: "nnccc"}},
+			},
+		},
+		{
+			name:       "wrong length",
+			readTokens: "lathe ccccc",
+			Config: Config{
+				Resume: result.Results{{Guess: "boat", Score: "nnnn"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "contradiction",
+			readTokens: "lathe ccccc",
+			Config: Config{
+				Resume: result.Results{{Guess: "lathe", Score: "nnnnn"}, {Guess: "lathe", Score: "ccccn"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "multiple boards",
+			readTokens: "lathe ccccc ccccc",
+			Config: Config{
+				Boards: 2,
+				Resume: result.Results{{Guess: "crumb", Score: "nnnnn"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
+				Writer: bufio.NewWriter(io.Discard),
+			}
+			var saved []result.Results
+			test.Config.Save = func(rs result.Results) error {
+				saved = append(saved, rs)
+				return nil
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe boast"), test.Config)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.wantSaved, saved):
+				t.Errorf("saved results not equal:\nwanted: %v\ngot:    %v", test.wantSaved, saved)
+			}
+		})
+	}
+}
+
+func TestRunWordleCheaterSaveError(t *testing.T) {
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("boast nnnnn n bathe ccccc")),
+		Writer: bufio.NewWriter(io.Discard),
+	}
+	cfg := Config{
+		Save: func(rs result.Results) error {
+			return fmt.Errorf("disk full")
+		},
+	}
+	if err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe boast"), cfg); err == nil {
+		t.Errorf("wanted save error")
+	}
+}
+
+func TestRunWordleCheaterShare(t *testing.T) {
+	sh := score.Share{Scores: []score.Score{"nnccc", "ccccc"}}
+	tests := []struct {
+		name       string
+		readTokens string
+		Config
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:       "paths",
+			readTokens: "bathe ncccc n lathe ccccc",
+			Config:     Config{Share: &sh},
+			want: []string{
+				"the shared grid allows 3 answers\n",
+				"shared guess 1 could have been (1): tithe\nshared guess 2 could have been (1): lathe\n",
+			},
+		},
+		{
+			name:       "wrong length",
+			readTokens: "lathe ccccc",
+			Config:     Config{Share: &sh, NumLetters: 4},
+			wantErr:    true,
+		},
+		{
+			name:       "multiple boards",
+			readTokens: "lathe ccccc ccccc",
+			Config:     Config{Share: &sh, Boards: 2},
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := bufio.ReadWriter{
+				Reader: bufio.NewReader(strings.NewReader(test.readTokens)),
+				Writer: bufio.NewWriter(&buf),
+			}
+			err := RunWordleCheater(rw, words.NewLexicon("bathe lathe tithe ghost"), test.Config)
+			rw.Flush()
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("wanted %q in output, got %q", want, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestWriteSharePathsLimit(t *testing.T) {
+	sh := score.Share{Scores: []score.Score{"n"}}
+	all := make(words.Words)
+	for ch := 'b'; ch <= 'm'; ch++ {
+		all[string(ch)] = struct{}{}
+	}
+	var sb strings.Builder
+	writeSharePaths(&sb, sh, "a", all)
+	if want, got := "shared guess 1 could have been (12): b,c,d,e,f,g,h,i,j,k,...\n", sb.String(); want != got {
+		t.Errorf("wanted %q, got %q", want, got)
+	}
+}
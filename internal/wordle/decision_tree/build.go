@@ -0,0 +1,218 @@
+package decision_tree
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+type (
+	// Goal is what the guesses of a tree are picked to minimize
+	Goal string
+	// Builder creates trees that solve every answer
+	Builder struct {
+		Goal Goal
+		// Opener is the first guess of the tree.  It is picked like the other guesses if it is empty.
+		Opener guess.Guess
+		// Candidates is the number of the best ranked guesses that are tried at each step of the tree.
+		// All the guesses are tried if it is not positive, which is very slow for large word lists.
+		Candidates int
+		// FromAll tries every word as a guess, not just the possible words
+		FromAll bool
+		// MaxGuesses is the number of guesses allowed to find each answer
+		MaxGuesses int
+	}
+	// Cost is the number of guesses a tree makes to solve its answers
+	Cost struct {
+		Answers int
+		// Guesses is the total number of guesses made to solve each answer
+		Guesses int
+		// Worst is the most guesses made to solve an answer
+		Worst int
+	}
+	// builder creates a tree, remembering the trees of sets of possible words that have already been built
+	builder struct {
+		Builder
+		all      words.Words
+		branches map[string]branch
+	}
+	// branch is a built tree and its cost, which is not ok if the words could not be solved
+	branch struct {
+		tree *Tree
+		cost Cost
+		ok   bool
+	}
+)
+
+const (
+	// Average trees make the fewest total guesses
+	Average Goal = "average"
+	// WorstCase trees make the fewest guesses to solve the hardest answer
+	WorstCase Goal = "worst"
+)
+
+// Goals are the goals that can be built
+var Goals = []Goal{Average, WorstCase}
+
+// Average is the average number of guesses to solve each answer
+func (c Cost) Average() float64 {
+	if c.Answers == 0 {
+		return 0
+	}
+	return float64(c.Guesses) / float64(c.Answers)
+}
+
+// Build creates a tree that solves every answer, making guesses from all the words.
+// An error is returned if an answer can not be solved in the max number of guesses by the tried guesses.
+func (b Builder) Build(answers, all words.Words) (*Tree, Cost, error) {
+	if !slices.Contains(Goals, b.Goal) {
+		return nil, Cost{}, fmt.Errorf("unknown goal %q, wanted one of %v", b.Goal, Goals)
+	}
+	if b.MaxGuesses <= 0 {
+		return nil, Cost{}, fmt.Errorf("wanted positive max guesses, got %v", b.MaxGuesses)
+	}
+	if len(answers) == 0 {
+		return nil, Cost{}, fmt.Errorf("no answers to build a tree for")
+	}
+	bb := builder{
+		Builder:  b,
+		all:      all,
+		branches: make(map[string]branch),
+	}
+	possible := slices.Sorted(maps.Keys(answers))
+	var br branch
+	switch {
+	case len(b.Opener) != 0:
+		br = bb.tryGuess(b.Opener, possible, b.MaxGuesses, branch{})
+	default:
+		br = bb.build(possible, b.MaxGuesses)
+	}
+	if !br.ok {
+		return nil, Cost{}, fmt.Errorf("can not solve %v answers in %v guesses with %v candidate guesses", len(answers), b.MaxGuesses, b.Candidates)
+	}
+	return br.tree, br.cost, nil
+}
+
+// build finds the best tree that solves the possible words in the remaining number of guesses
+func (b *builder) build(possible []string, remaining int) branch {
+	switch {
+	case remaining <= 0:
+		return branch{}
+	case len(possible) == 1:
+		return branch{
+			tree: &Tree{Guess: guess.Guess(possible[0])},
+			cost: Cost{Answers: 1, Guesses: 1, Worst: 1},
+			ok:   true,
+		}
+	case remaining == 1:
+		return branch{}
+	case len(possible) == 2:
+		// guessing one of the words is the best that can be done
+		return b.tryGuess(guess.Guess(possible[0]), possible, remaining, branch{})
+	}
+	key := strconv.Itoa(remaining) + ":" + strings.Join(possible, ",")
+	if br, ok := b.branches[key]; ok {
+		return br
+	}
+	var best branch
+	for _, g := range b.candidates(possible) {
+		if br := b.tryGuess(g, possible, remaining, best); br.ok {
+			best = br
+		}
+	}
+	b.branches[key] = best
+	return best
+}
+
+// candidates ranks the guesses to try for the possible words.
+// The guesses that leave the fewest words are tried first for worst case trees.
+func (b *builder) candidates(possible []string) []guess.Guess {
+	m := make(words.Words, len(possible))
+	for _, w := range possible {
+		m[w] = struct{}{}
+	}
+	r := recommend.Recommender{
+		FromAll: b.FromAll,
+		Count:   b.Candidates,
+	}
+	if b.Goal == WorstCase {
+		r.Strategy = recommend.Minimax
+	}
+	recommendations := r.Rank(m, b.all)
+	guesses := make([]guess.Guess, len(recommendations))
+	for i, rec := range recommendations {
+		guesses[i] = rec.Guess
+	}
+	return guesses
+}
+
+// tryGuess builds the tree that makes the guess first.
+// The branch is not ok if the guess does not split the possible words or its tree is not better than the best branch.
+func (b *builder) tryGuess(g guess.Guess, possible []string, remaining int, best branch) branch {
+	buckets := make(map[score.Score][]string)
+	for _, w := range possible {
+		s := score.Compute(string(g), w)
+		buckets[s] = append(buckets[s], w)
+	}
+	allCorrect := score.AllCorrect(len([]rune(string(g))))
+	if _, ok := buckets[allCorrect]; !ok && len(buckets) == 1 {
+		return branch{} // the guess gives no information
+	}
+	// the bound is the cost of the tree if every other answer is solved by the next guess
+	bound := Cost{Answers: len(possible), Guesses: 2 * len(possible), Worst: 2}
+	if ws, ok := buckets[allCorrect]; ok {
+		bound.Guesses--
+		if len(ws) == len(possible) {
+			bound.Worst = 1
+		}
+	}
+	t := Tree{
+		Guess: g,
+		Next:  make(map[score.Score]*Tree, len(buckets)),
+	}
+	for _, s := range slices.Sorted(maps.Keys(buckets)) {
+		ws := buckets[s]
+		if s == allCorrect {
+			continue
+		}
+		if best.ok && !b.better(bound, best.cost) {
+			return branch{}
+		}
+		br := b.build(ws, remaining-1)
+		if !br.ok {
+			return branch{}
+		}
+		t.Next[s] = br.tree
+		bound.Guesses += br.cost.Guesses - len(ws) // the next guesses were counted as one for each word
+		bound.Worst = max(bound.Worst, br.cost.Worst+1)
+	}
+	if best.ok && !b.better(bound, best.cost) {
+		return branch{}
+	}
+	if len(t.Next) == 0 {
+		t.Next = nil
+	}
+	return branch{
+		tree: &t,
+		cost: bound,
+		ok:   true,
+	}
+}
+
+// better determines if the cost is less than the other cost, for the goal of the builder
+func (b *builder) better(c, other Cost) bool {
+	switch {
+	case b.Goal == WorstCase && c.Worst != other.Worst:
+		return c.Worst < other.Worst
+	case c.Guesses != other.Guesses:
+		return c.Guesses < other.Guesses
+	}
+	return c.Worst < other.Worst
+}
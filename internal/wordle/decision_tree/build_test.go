@@ -0,0 +1,154 @@
+package decision_tree
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+)
+
+// testWords have many words that share most of their letters, so they take many guesses to tell apart
+var testWords = newWords("batch catch hatch latch match baker maker taker waker crane slate trace irate stare")
+
+func newWords(text string) words.Words {
+	m := make(words.Words)
+	for _, w := range strings.Fields(text) {
+		m[w] = struct{}{}
+	}
+	return m
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name string
+		Builder
+		answers words.Words
+		wantErr bool
+	}{
+		{
+			name:    "average",
+			Builder: Builder{Goal: Average, MaxGuesses: 6},
+			answers: testWords,
+		},
+		{
+			name:    "worst case",
+			Builder: Builder{Goal: WorstCase, MaxGuesses: 6},
+			answers: testWords,
+		},
+		{
+			name:    "guesses from all words",
+			Builder: Builder{Goal: Average, FromAll: true, Candidates: 3, MaxGuesses: 6},
+			answers: newWords("batch catch hatch latch match"),
+		},
+		{
+			name:    "opener",
+			Builder: Builder{Goal: Average, Opener: "crane", MaxGuesses: 6},
+			answers: testWords,
+		},
+		{
+			name:    "single answer",
+			Builder: Builder{Goal: Average, MaxGuesses: 1},
+			answers: newWords("crane"),
+		},
+		{
+			name:    "not enough guesses",
+			Builder: Builder{Goal: Average, MaxGuesses: 3},
+			answers: newWords("batch catch hatch latch match"),
+			wantErr: true,
+		},
+		{
+			name:    "unknown goal",
+			Builder: Builder{Goal: "best", MaxGuesses: 6},
+			answers: testWords,
+			wantErr: true,
+		},
+		{
+			name:    "no max guesses",
+			Builder: Builder{Goal: Average},
+			answers: testWords,
+			wantErr: true,
+		},
+		{
+			name:    "no answers",
+			Builder: Builder{Goal: Average, MaxGuesses: 6},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tree, cost, err := test.Builder.Build(test.answers, testWords)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+				return
+			case err != nil:
+				t.Fatalf("unwanted error: %v", err)
+			case len(test.Opener) != 0 && tree.Guess != test.Opener:
+				t.Errorf("wanted opener %v, got %v", test.Opener, tree.Guess)
+			}
+			played := Cost{Answers: len(test.answers)}
+			for answer := range test.answers {
+				guesses, solved := tree.Play(answer)
+				if !solved {
+					t.Errorf("%v not solved: %v", answer, guesses)
+				}
+				played.Guesses += len(guesses)
+				played.Worst = max(played.Worst, len(guesses))
+			}
+			if played != cost {
+				t.Errorf("cost not equal to the played games:\nwanted: %+v\ngot:    %+v", played, cost)
+			}
+			if cost.Worst > test.MaxGuesses {
+				t.Errorf("wanted at most %v guesses, got %v", test.MaxGuesses, cost.Worst)
+			}
+		})
+	}
+}
+
+func TestBuildGoals(t *testing.T) {
+	builders := map[Goal]Builder{}
+	costs := make(map[Goal]Cost)
+	for _, goal := range Goals {
+		builders[goal] = Builder{Goal: goal, FromAll: true, MaxGuesses: 6}
+		_, cost, err := builders[goal].Build(testWords, testWords)
+		if err != nil {
+			t.Fatalf("building %v tree: unwanted error: %v", goal, err)
+		}
+		costs[goal] = cost
+	}
+	if a, w := costs[Average], costs[WorstCase]; a.Guesses > w.Guesses {
+		t.Errorf("average tree made more guesses than the worst case tree: %v > %v", a.Guesses, w.Guesses)
+	}
+	if a, w := costs[Average], costs[WorstCase]; w.Worst > a.Worst {
+		t.Errorf("worst case tree had a worse game than the average tree: %v > %v", w.Worst, a.Worst)
+	}
+}
+
+func TestBuilderCandidates(t *testing.T) {
+	// extra gives the most information, but mayor leaves the fewest words in the worst case
+	possible := []string{"extra", "joint", "known", "mayor", "night", "stuff", "trade"}
+	all := newWords(strings.Join(possible, " "))
+	tests := []struct {
+		goal Goal
+		want guess.Guess
+	}{
+		{Average, "extra"},
+		{WorstCase, "mayor"},
+	}
+	for _, test := range tests {
+		t.Run(string(test.goal), func(t *testing.T) {
+			b := builder{
+				Builder: Builder{Goal: test.goal, Candidates: 1},
+				all:     all,
+			}
+			got := b.candidates(possible)
+			if want := []guess.Guess{test.want}; !reflect.DeepEqual(want, got) {
+				t.Errorf("wanted %v, got %v", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Package decision_tree stores a strategy of guesses for every score, so the next guess of a game can be looked up instead of searched for.
+package decision_tree
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+// Tree is a guess and the trees of the guesses to make after each score it can get.
+// The game is solved when the guess is scored as all correct, so that score has no tree.
+// The text form has a line for each guess, with the score that leads to it, indented by two spaces for each earlier guess:
+//
+//	salet
+//	  nnnnn courd
+//	    nnnnn whiny
+//	  nnnna trice
+type Tree struct {
+	Guess guess.Guess           `json:"guess"`
+	Next  map[score.Score]*Tree `json:"next,omitempty"`
+}
+
+// indent is the text before each line of the text form for each earlier guess
+const indent = "  "
+
+// Parse reads a tree from its text or JSON form.  JSON trees start with a '{'.
+func Parse(data []byte) (*Tree, error) {
+	var t Tree
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if err := json.Unmarshal(data, &t); err != nil {
+			return nil, fmt.Errorf("parsing JSON tree: %w", err)
+		}
+		if err := t.validate(t.NumLetters()); err != nil {
+			return nil, err
+		}
+		return &t, nil
+	}
+	if err := t.UnmarshalText(data); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// NumLetters is the length of the words of the tree
+func (t Tree) NumLetters() int {
+	return utf8.RuneCountInString(string(t.Guess))
+}
+
+// NextGuess follows the tree down the path of the results to find the guess to make after them.
+// An error is returned if a guess of the results is not the guess of the tree, or the tree has no guess after a score.
+func (t *Tree) NextGuess(rs result.Results) (guess.Guess, error) {
+	numLetters := t.NumLetters()
+	for i, r := range rs {
+		switch {
+		case r.Guess != t.Guess:
+			return "", fmt.Errorf("guess %v is %v, but the tree guesses %v", i+1, r.Guess, t.Guess)
+		case r.Score == score.AllCorrect(numLetters):
+			return "", fmt.Errorf("guess %v is the answer", i+1)
+		}
+		next, ok := t.Next[r.Score]
+		if !ok {
+			return "", fmt.Errorf("the tree has no guess after %v is scored %v", r.Guess, r.Score)
+		}
+		t = next
+	}
+	return t.Guess, nil
+}
+
+// Play makes the guesses of the tree until the answer is guessed.
+// The game is not solved if the tree has no guess for a score.
+func (t *Tree) Play(answer string) (guesses []guess.Guess, solved bool) {
+	for {
+		guesses = append(guesses, t.Guess)
+		s := score.Compute(string(t.Guess), answer)
+		if s == score.AllCorrect(t.NumLetters()) {
+			return guesses, true
+		}
+		next, ok := t.Next[s]
+		if !ok {
+			return guesses, false
+		}
+		t = next
+	}
+}
+
+// MarshalText encodes the tree as indented lines of scores and guesses
+func (t Tree) MarshalText() ([]byte, error) {
+	if err := t.validate(t.NumLetters()); err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	b.WriteString(string(t.Guess))
+	b.WriteByte('\n')
+	t.writeNext(&b, 1)
+	return []byte(b.String()), nil
+}
+
+// writeNext writes a line for each score of the tree and the lines of its next tree, in the order of the scores
+func (t Tree) writeNext(b *strings.Builder, depth int) {
+	for _, s := range slices.Sorted(maps.Keys(t.Next)) {
+		next := t.Next[s]
+		fmt.Fprintf(b, "%v%v %v\n", strings.Repeat(indent, depth), s, next.Guess)
+		next.writeNext(b, depth+1)
+	}
+}
+
+// UnmarshalText decodes the tree from indented lines of scores and guesses.  Blank lines are skipped.
+func (t *Tree) UnmarshalText(text []byte) error {
+	var root *Tree
+	var path []*Tree // the trees of the last line at each depth
+	for i, line := range strings.Split(string(text), "\n") {
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+		trimmed := strings.TrimLeft(line, " ")
+		spaces := len(line) - len(trimmed)
+		depth := spaces / len(indent)
+		fields := strings.Fields(trimmed)
+		switch {
+		case spaces%len(indent) != 0:
+			return fmt.Errorf("line %v: wanted indent to be a multiple of %q", i+1, indent)
+		case root == nil && (depth != 0 || len(fields) != 1):
+			return fmt.Errorf("line %v: wanted the first guess without indent", i+1)
+		case root == nil:
+			root = &Tree{Guess: guess.New(fields[0])}
+			path = []*Tree{root}
+			continue
+		case depth == 0 || depth > len(path):
+			return fmt.Errorf("line %v: wanted indent of 1 to %v levels, got %v", i+1, len(path), depth)
+		case len(fields) != 2:
+			return fmt.Errorf("line %v: wanted a score and a guess", i+1)
+		}
+		parent := path[depth-1]
+		s := score.New(fields[0])
+		if _, ok := parent.Next[s]; ok {
+			return fmt.Errorf("line %v: the score %v is already in the tree after %v", i+1, s, parent.Guess)
+		}
+		if parent.Next == nil {
+			parent.Next = make(map[score.Score]*Tree)
+		}
+		next := &Tree{Guess: guess.New(fields[1])}
+		parent.Next[s] = next
+		path = append(path[:depth], next)
+	}
+	if root == nil {
+		return fmt.Errorf("wanted a guess")
+	}
+	if err := root.validate(root.NumLetters()); err != nil {
+		return err
+	}
+	*t = *root
+	return nil
+}
+
+// MarshalJSON encodes the tree as nested objects, not as the text form
+func (t Tree) MarshalJSON() ([]byte, error) {
+	type tree Tree // does not have the methods of the tree
+	return json.Marshal(tree(t))
+}
+
+// UnmarshalJSON decodes the tree from nested objects, not from the text form
+func (t *Tree) UnmarshalJSON(data []byte) error {
+	type tree Tree
+	return json.Unmarshal(data, (*tree)(t))
+}
+
+// validate ensures the guesses and scores of the tree are numLetters long.
+// A score that is all correct can not lead to another guess.
+func (t Tree) validate(numLetters int) error {
+	var anyWord words.Words
+	if err := t.Guess.Validate(anyWord, numLetters); err != nil {
+		return fmt.Errorf("invalid tree guess %q: %w", t.Guess, err)
+	}
+	for s, next := range t.Next {
+		switch err := s.Validate(numLetters); {
+		case err != nil:
+			return fmt.Errorf("invalid score after %v: %w", t.Guess, err)
+		case s == score.AllCorrect(numLetters):
+			return fmt.Errorf("the tree has a guess after %v is correct", t.Guess)
+		case next == nil:
+			return fmt.Errorf("the tree has no guess after %v is scored %v", t.Guess, s)
+		}
+		if err := next.validate(numLetters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
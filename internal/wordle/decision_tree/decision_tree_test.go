@@ -0,0 +1,141 @@
+package decision_tree
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+// testTree guesses "salet", then "crane" if the last two letters are almost correct, or "fight" if only the last letter is correct
+var testTree = Tree{
+	Guess: "salet",
+	Next: map[score.Score]*Tree{
+		"nnnaa": {
+			Guess: "crane",
+			Next: map[score.Score]*Tree{
+				"ncnnc": {Guess: "trite"},
+			},
+		},
+		"nnnnc": {Guess: "fight"},
+	},
+}
+
+const testTreeText = `salet
+  nnnaa crane
+    ncnnc trite
+  nnnnc fight
+`
+
+func TestMarshalText(t *testing.T) {
+	got, err := testTree.MarshalText()
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case string(got) != testTreeText:
+		t.Errorf("not equal:\nwanted: %q\ngot:    %q", testTreeText, got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	jsonText, err := json.Marshal(testTree)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	tests := []struct {
+		name    string
+		text    string
+		want    *Tree
+		wantErr bool
+	}{
+		{"text", testTreeText, &testTree, false},
+		{"text with blank lines and other notations", "\nsalet\n  ⬜⬜⬜🟨🟨 CRANE\n\n    ⬜🟩⬜⬜🟩 trite\n  nnnnc fight\n", &testTree, false},
+		{"json", string(jsonText), &testTree, false},
+		{"json with leading space", "\n " + string(jsonText), &testTree, false},
+		{"single guess", "crane", &Tree{Guess: "crane"}, false},
+		{"empty", "", nil, true},
+		{"indented first guess", "  salet", nil, true},
+		{"first line has score", "nnnnn salet", nil, true},
+		{"odd indent", "salet\n   nnnnn crane", nil, true},
+		{"too much indent", "salet\n    nnnnn crane", nil, true},
+		{"missing guess", "salet\n  nnnnn", nil, true},
+		{"duplicate score", "salet\n  nnnnn crane\n  nnnnn fight", nil, true},
+		{"bad score", "salet\n  nnxnn crane", nil, true},
+		{"short guess", "salet\n  nnnnn cran", nil, true},
+		{"guess after correct", "salet\n  ccccc crane", nil, true},
+		{"bad json", `{"guess": "salet", "next": {"nnnnn": 3}}`, nil, true},
+		{"json without next guess", `{"guess": "salet", "next": {"nnnnn": null}}`, nil, true},
+		{"json with short score", `{"guess": "salet", "next": {"nnnn": {"guess": "crane"}}}`, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse([]byte(test.text))
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNextGuess(t *testing.T) {
+	tests := []struct {
+		name    string
+		results result.Results
+		want    guess.Guess
+		wantErr bool
+	}{
+		{"opener", nil, "salet", false},
+		{"second guess", result.Results{{Guess: "salet", Score: "nnnaa"}}, "crane", false},
+		{"third guess", result.Results{{Guess: "salet", Score: "nnnaa"}, {Guess: "crane", Score: "ncnnc"}}, "trite", false},
+		{"other guess", result.Results{{Guess: "crane", Score: "nnnnn"}}, "", true},
+		{"unknown score", result.Results{{Guess: "salet", Score: "nnnnn"}}, "", true},
+		{"solved", result.Results{{Guess: "salet", Score: "ccccc"}}, "", true},
+		{"past end of tree", result.Results{{Guess: "salet", Score: "nnnnc"}, {Guess: "fight", Score: "nnnnn"}}, "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := testTree.NextGuess(test.results)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case test.want != got:
+				t.Errorf("wanted %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPlay(t *testing.T) {
+	tests := []struct {
+		answer     string
+		want       []guess.Guess
+		wantSolved bool
+	}{
+		{"salet", []guess.Guess{"salet"}, true},
+		{"fight", []guess.Guess{"salet", "fight"}, true},
+		{"trite", []guess.Guess{"salet", "crane", "trite"}, true},
+		{"theme", []guess.Guess{"salet", "crane"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.answer, func(t *testing.T) {
+			got, solved := testTree.Play(test.answer)
+			if !reflect.DeepEqual(test.want, got) || test.wantSolved != solved {
+				t.Errorf("not equal:\nwanted: %v %v\ngot:    %v %v", test.want, test.wantSolved, got, solved)
+			}
+		})
+	}
+}
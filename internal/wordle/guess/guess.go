@@ -4,12 +4,11 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"unicode/utf8"
 
 	words "github.com/jacobpatterson1549/wordle-cheater"
 )
 
-const numLetters = 5
-
 // Guess is a word that might be the answer
 type Guess string
 
@@ -20,8 +19,9 @@ func New(word string) Guess {
 	return g
 }
 
-// Scan prompts for a guess on the ReadWriter until a valid one is given or an io error occurs
-func Scan(rw io.ReadWriter, m words.Words) (*Guess, error) {
+// Scan prompts for a guess on the ReadWriter until a valid one is given or an io error occurs.
+// Extra validators can be provided to further restrict the guess.
+func Scan(rw io.ReadWriter, m words.Words, numLetters int, validators ...func(g Guess) error) (*Guess, error) {
 	for {
 		fmt.Fprintf(rw, "Enter guess (%v letters): ", numLetters)
 		var word string
@@ -29,7 +29,11 @@ func Scan(rw io.ReadWriter, m words.Words) (*Guess, error) {
 			return nil, fmt.Errorf("scanning guess: %v", err)
 		}
 		g := New(word)
-		if err := g.Validate(m); err != nil {
+		if err := g.Validate(m, numLetters); err != nil {
+			fmt.Fprintf(rw, "%v\n", err)
+			continue
+		}
+		if err := validate(g, validators); err != nil {
 			fmt.Fprintf(rw, "%v\n", err)
 			continue
 		}
@@ -37,9 +41,9 @@ func Scan(rw io.ReadWriter, m words.Words) (*Guess, error) {
 	}
 }
 
-// Validate ensures the guess is <<numLetters>> letters long and is in the words list (if a list is provided)
-func (g Guess) Validate(m words.Words) error {
-	if len(g) != numLetters {
+// Validate ensures the guess is numLetters letters long and is in the words list (if a list is provided)
+func (g Guess) Validate(m words.Words, numLetters int) error {
+	if utf8.RuneCountInString(string(g)) != numLetters {
 		return fmt.Errorf("guess must be %v letters long", numLetters)
 	}
 	if len(m) > 0 {
@@ -49,3 +53,13 @@ func (g Guess) Validate(m words.Words) error {
 	}
 	return nil
 }
+
+// validate runs the validators on the guess, returning the first error
+func validate(g Guess, validators []func(g Guess) error) error {
+	for _, v := range validators {
+		if err := v(g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
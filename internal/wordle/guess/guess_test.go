@@ -2,6 +2,7 @@ package guess
 
 import (
 	"bufio"
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -48,7 +49,7 @@ func TestScan(t *testing.T) {
 			Reader: bufio.NewReader(strings.NewReader(test.in)),
 			Writer: bufio.NewWriter(&buf),
 		}
-		got, gotErr := Scan(rw, test.allWords)
+		got, gotErr := Scan(rw, test.allWords, 5)
 		rw.Flush()
 		switch {
 		case test.wantErr:
@@ -64,3 +65,54 @@ func TestScan(t *testing.T) {
 		}
 	}
 }
+
+func TestScanValidators(t *testing.T) {
+	noZ := func(g Guess) error {
+		if strings.ContainsRune(string(g), 'z') {
+			return fmt.Errorf("guess must not contain Z")
+		}
+		return nil
+	}
+	var buf strings.Builder
+	rw := bufio.ReadWriter{
+		Reader: bufio.NewReader(strings.NewReader("zesty happy")),
+		Writer: bufio.NewWriter(&buf),
+	}
+	got, err := Scan(rw, nil, 5, noZ)
+	rw.Flush()
+	wantOut := "Enter guess (5 letters): guess must not contain Z\nEnter guess (5 letters): "
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case *got != "happy":
+		t.Errorf("wanted happy, got %v", *got)
+	case wantOut != buf.String():
+		t.Errorf("outputs not equal:\nwanted: %q\ngot:    %q", wantOut, buf.String())
+	}
+}
+
+func TestValidateLength(t *testing.T) {
+	tests := []struct {
+		Guess
+		numLetters int
+		wantOk     bool
+	}{
+		{"word", 4, true},
+		{"words", 4, false},
+		{"wordle", 6, true},
+		{"größe", 5, true},
+	}
+	for _, test := range tests {
+		t.Run(string(test.Guess), func(t *testing.T) {
+			err := test.Guess.Validate(nil, test.numLetters)
+			switch {
+			case err != nil:
+				if test.wantOk {
+					t.Errorf("unwanted error: %v", err)
+				}
+			case !test.wantOk:
+				t.Errorf("wanted error")
+			}
+		})
+	}
+}
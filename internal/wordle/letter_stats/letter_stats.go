@@ -0,0 +1,99 @@
+// Package letter_stats counts how common letters are in the possible words, overall and at each position.
+package letter_stats
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+)
+
+type (
+	// Stats are the counts of the letters of some words
+	Stats struct {
+		// Words is the number of words that were counted
+		Words int
+		// Letters has the counts of each letter that is in any of the words, most common first
+		Letters []Letter
+	}
+	// Letter is a row of the position-by-letter matrix: how many words have the letter
+	Letter struct {
+		Letter rune
+		// Total is the number of words that have the letter at least once
+		Total int
+		// Positions is the number of words that have the letter at each position
+		Positions []int
+	}
+)
+
+// heatmapShades are the characters of a heatmap cell, from a letter not being at a position to being there in every word
+const heatmapShades = " .:-=+*#%@"
+
+// New counts the letters of the words.
+// The words can have different lengths: the positions go up to the length of the longest word.
+func New(m words.Words) Stats {
+	numLetters := 0
+	for w := range m {
+		numLetters = max(numLetters, utf8.RuneCountInString(w))
+	}
+	rows := make(map[rune]*Letter)
+	for w := range m {
+		runes := []rune(w)
+		seen := make(map[rune]bool, len(runes))
+		for i, ch := range runes {
+			l, ok := rows[ch]
+			if !ok {
+				l = &Letter{
+					Letter:    ch,
+					Positions: make([]int, numLetters),
+				}
+				rows[ch] = l
+			}
+			l.Positions[i]++
+			if !seen[ch] {
+				l.Total++
+				seen[ch] = true
+			}
+		}
+	}
+	s := Stats{
+		Words:   len(m),
+		Letters: make([]Letter, 0, len(rows)),
+	}
+	for _, l := range rows {
+		s.Letters = append(s.Letters, *l)
+	}
+	slices.SortFunc(s.Letters, func(a, b Letter) int {
+		if a.Total != b.Total {
+			return cmp.Compare(b.Total, a.Total)
+		}
+		return cmp.Compare(a.Letter, b.Letter)
+	})
+	return s
+}
+
+// WriteHeatmap writes a line for each letter with its total and a character for each position that is darker when more words have the letter there
+func (s Stats) WriteHeatmap(w io.Writer) {
+	fmt.Fprintf(w, "letters of %v words (%q is none, %q is all):\n", s.Words, heatmapShades[0], heatmapShades[len(heatmapShades)-1])
+	for _, l := range s.Letters {
+		var b strings.Builder
+		for _, count := range l.Positions {
+			b.WriteByte(heatmapShades[s.shade(count)])
+		}
+		fmt.Fprintf(w, "%c %*v |%v|\n", l.Letter, len(fmt.Sprint(s.Words)), l.Total, b.String())
+	}
+}
+
+// shade is the index of the heatmap character for the count.
+// It rounds up so that a letter that is at a position in any word is not shown as being in none.
+func (s Stats) shade(count int) int {
+	if s.Words == 0 {
+		return 0
+	}
+	n := len(heatmapShades) - 1
+	return (count*n + s.Words - 1) / s.Words
+}
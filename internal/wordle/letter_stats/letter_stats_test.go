@@ -0,0 +1,96 @@
+package letter_stats
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+)
+
+func TestNew(t *testing.T) {
+	m := words.Words{"bathe": {}, "lathe": {}, "tithe": {}}
+	tests := []struct {
+		name string
+		m    words.Words
+		want Stats
+	}{
+		{
+			name: "no words",
+			want: Stats{Letters: []Letter{}},
+		},
+		{
+			name: "all words",
+			m:    m,
+			want: Stats{
+				Words: 3,
+				Letters: []Letter{
+					{'e', 3, []int{0, 0, 0, 0, 3}},
+					{'h', 3, []int{0, 0, 0, 3, 0}},
+					{'t', 3, []int{1, 0, 3, 0, 0}},
+					{'a', 2, []int{0, 2, 0, 0, 0}},
+					{'b', 1, []int{1, 0, 0, 0, 0}},
+					{'i', 1, []int{0, 1, 0, 0, 0}},
+					{'l', 1, []int{1, 0, 0, 0, 0}},
+				},
+			},
+		},
+		{
+			name: "mixed lengths",
+			m:    words.Words{"at": {}, "bat": {}, "tab": {}},
+			want: Stats{
+				Words: 3,
+				Letters: []Letter{
+					{'a', 3, []int{1, 2, 0}},
+					{'t', 3, []int{1, 1, 1}},
+					{'b', 2, []int{1, 0, 1}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := New(test.m)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestWriteHeatmap(t *testing.T) {
+	m := words.Words{"bathe": {}, "lathe": {}, "tithe": {}}
+	s := New(m)
+	var sb strings.Builder
+	s.WriteHeatmap(&sb)
+	want := "letters of 3 words (' ' is none, '@' is all):\n" +
+		"e 3 |    @|\n" +
+		"h 3 |   @ |\n" +
+		"t 3 |- @  |\n" +
+		"a 2 | *   |\n" +
+		"b 1 |-    |\n" +
+		"i 1 | -   |\n" +
+		"l 1 |-    |\n"
+	if got := sb.String(); want != got {
+		t.Errorf("not equal:\nwanted: %q\ngot:    %q", want, got)
+	}
+}
+
+func TestShade(t *testing.T) {
+	tests := []struct {
+		words, count, want int
+	}{
+		{0, 0, 0},
+		{100, 0, 0},
+		{100, 1, 1},
+		{100, 50, 5},
+		{100, 99, 9},
+		{100, 100, 9},
+	}
+	for _, test := range tests {
+		s := Stats{Words: test.words}
+		if got := s.shade(test.count); test.want != got {
+			t.Errorf("shade of %v of %v words: wanted %v, got %v", test.count, test.words, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package multi_board
+
+import (
+	"fmt"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+type (
+	// Board tracks the results of the guesses for one of the answers
+	Board struct {
+		Results  []result.Result
+		Possible words.Words
+		history  result.History
+	}
+	// Boards are solved together, with each guess scored against every unsolved board
+	Boards []Board
+)
+
+// MaxGuesses is the number of guesses usually allowed to solve the boards: five more than the number of boards
+func MaxGuesses(boardCount int) int {
+	return boardCount + 5
+}
+
+// New creates boards that can each have any of the answers, which are words of the alphabet
+func New(boardCount int, answers words.Words, alphabet *char_set.Alphabet) (Boards, error) {
+	if boardCount <= 0 {
+		return nil, fmt.Errorf("wanted positive board count, got %v", boardCount)
+	}
+	bs := make(Boards, boardCount)
+	for i := range bs {
+		bs[i].Possible = *answers.Copy()
+		bs[i].history = result.NewHistory(alphabet)
+	}
+	return bs, nil
+}
+
+// Solved determines if the last result of the board was all correct
+func (b Board) Solved() bool {
+	if len(b.Results) == 0 {
+		return false
+	}
+	s := b.Results[len(b.Results)-1].Score
+	return s == score.AllCorrect(len(s))
+}
+
+// Unsolved lists the indexes of the boards that are not solved, in order
+func (bs Boards) Unsolved() []int {
+	var unsolved []int
+	for i, b := range bs {
+		if !b.Solved() {
+			unsolved = append(unsolved, i)
+		}
+	}
+	return unsolved
+}
+
+// Solved determines if every board is solved
+func (bs Boards) Solved() bool {
+	return len(bs.Unsolved()) == 0
+}
+
+// Possibles lists the possible words of each unsolved board
+func (bs Boards) Possibles() []words.Words {
+	unsolved := bs.Unsolved()
+	possibles := make([]words.Words, len(unsolved))
+	for i, j := range unsolved {
+		possibles[i] = bs[j].Possible
+	}
+	return possibles
+}
+
+// AddGuess adds the result of the guess to each of the unsolved boards.
+// There must be one score for each unsolved board, in order.
+// No boards are changed if any of the results contradict earlier results.
+func (bs Boards) AddGuess(g guess.Guess, scores []score.Score) error {
+	unsolved := bs.Unsolved()
+	if len(scores) != len(unsolved) {
+		return fmt.Errorf("wanted %v scores for the unsolved boards, got %v", len(unsolved), len(scores))
+	}
+	results := make([]result.Result, len(unsolved))
+	for i, j := range unsolved {
+		results[i] = result.Result{
+			Guess: g,
+			Score: scores[i],
+		}
+		if err := bs[j].history.CheckResult(results[i]); err != nil {
+			return fmt.Errorf("board %v: %w", j+1, err)
+		}
+	}
+	for i, j := range unsolved {
+		b := &bs[j]
+		if err := b.history.AddResult(results[i], &b.Possible); err != nil {
+			return fmt.Errorf("board %v: %w", j+1, err)
+		}
+		b.Results = append(b.Results, results[i])
+	}
+	return nil
+}
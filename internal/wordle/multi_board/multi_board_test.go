@@ -0,0 +1,100 @@
+package multi_board
+
+import (
+	"reflect"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+func TestNew(t *testing.T) {
+	all := words.Words{"apple": {}, "berry": {}}
+	t.Run("ok", func(t *testing.T) {
+		bs, err := New(2, all, nil)
+		switch {
+		case err != nil:
+			t.Fatalf("unwanted error: %v", err)
+		case len(bs) != 2:
+			t.Fatalf("wanted 2 boards, got %v", len(bs))
+		}
+		delete(bs[0].Possible, "apple")
+		if !reflect.DeepEqual(all, bs[1].Possible) {
+			t.Errorf("boards should have separate possible words: %v", bs[1].Possible)
+		}
+	})
+	t.Run("no boards", func(t *testing.T) {
+		if _, err := New(0, all, nil); err == nil {
+			t.Errorf("wanted error")
+		}
+	})
+}
+
+func TestMaxGuesses(t *testing.T) {
+	tests := []struct {
+		boardCount int
+		want       int
+	}{
+		{1, 6},
+		{2, 7},
+		{4, 9},
+		{8, 13},
+		{16, 21},
+	}
+	for _, test := range tests {
+		if got := MaxGuesses(test.boardCount); test.want != got {
+			t.Errorf("%v boards: wanted %v, got %v", test.boardCount, test.want, got)
+		}
+	}
+}
+
+func TestBoardsAddGuess(t *testing.T) {
+	all := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}}
+	bs, err := New(3, all, nil)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	if err := bs.AddGuess("lathe", []score.Score{"ccccc", "ncccc", "cnccc"}); err != nil {
+		t.Fatalf("unwanted error adding first guess: %v", err)
+	}
+	if want, got := []int{1, 2}, bs.Unsolved(); !reflect.DeepEqual(want, got) {
+		t.Errorf("unsolved boards not equal after first guess: wanted %v, got %v", want, got)
+	}
+	wantPossibles := []words.Words{{"bathe": {}}, {"lithe": {}}}
+	if got := bs.Possibles(); !reflect.DeepEqual(wantPossibles, got) {
+		t.Errorf("possible words not equal: \n wanted: %v \n    got: %v", wantPossibles, got)
+	}
+	if err := bs.AddGuess("bathe", []score.Score{"ccccc"}); err == nil {
+		t.Errorf("wanted error adding guess with too few scores")
+	}
+	if err := bs.AddGuess("lathe", []score.Score{"nnnnn", "ccccc"}); err == nil {
+		t.Errorf("wanted error adding guess that contradicts the first board")
+	}
+	if want, got := 1, len(bs[2].Results); want != got {
+		t.Errorf("wanted no boards to change after a contradiction, got %v results for the last board", got)
+	}
+	if err := bs.AddGuess("lithe", []score.Score{"nnccc", "ccccc"}); err != nil {
+		t.Fatalf("unwanted error adding second guess: %v", err)
+	}
+	if err := bs.AddGuess("bathe", []score.Score{"ccccc"}); err != nil {
+		t.Fatalf("unwanted error adding third guess: %v", err)
+	}
+	if !bs.Solved() {
+		t.Errorf("wanted all boards to be solved")
+	}
+	wantGuesses := [][]guess.Guess{
+		{"lathe"},
+		{"lathe", "lithe", "bathe"},
+		{"lathe", "lithe"},
+	}
+	for i, b := range bs {
+		var gotGuesses []guess.Guess
+		for _, r := range b.Results {
+			gotGuesses = append(gotGuesses, r.Guess)
+		}
+		if !reflect.DeepEqual(wantGuesses[i], gotGuesses) {
+			t.Errorf("board %v guesses not equal: wanted %v, got %v", i, wantGuesses[i], gotGuesses)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package recommend
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+type (
+	// Strategy is how guesses are ranked
+	Strategy string
+	// Recommender ranks guesses by how much they are expected to narrow down the possible words.
+	Recommender struct {
+		// Strategy ranks the guesses, by entropy if it is empty
+		Strategy Strategy
+		// FromAll ranks every word in the dictionary instead of only the possible words
+		FromAll bool
+		// Count limits the number of recommendations.  All are returned if it is not positive.
+		Count int
+		// Priors weigh how likely each possible word is to be the answer.  All possible words are equally likely if nil.
+		Priors *words.Frequencies
+		// MaxScores limits the number of scores computed to rank the guesses, which is the number of guesses times the number of possible words.
+		// Only an evenly spaced sample of the guesses is ranked when there would be more, keeping the possible words first.
+		// There is no limit if it is not positive.
+		MaxScores int
+	}
+	// Recommendation is a guess and its expected information gain
+	Recommendation struct {
+		Guess guess.Guess
+		// Entropy is the expected number of bits of information the guess will reveal
+		Entropy float64
+		// Largest is the most possible words that can be left after the guess is scored, its worst case
+		Largest int
+		// Outcomes is the number of different scores the guess can get
+		Outcomes int
+		// Possible indicates the guess could be the answer (of any board)
+		Possible bool
+	}
+)
+
+const (
+	// Entropy ranks the guesses that are expected to reveal the most information first
+	Entropy Strategy = "entropy"
+	// Minimax ranks the guesses that leave the fewest possible words in the worst case first, which is the safest choice when few guesses are left
+	Minimax Strategy = "minimax"
+)
+
+// Strategies are the strategies that can rank guesses
+var Strategies = []Strategy{Entropy, Minimax}
+
+// ParseStrategy finds the strategy with the name.  The empty name is the entropy strategy.
+func ParseStrategy(name string) (Strategy, error) {
+	if len(name) == 0 {
+		return Entropy, nil
+	}
+	s := Strategy(name)
+	if !slices.Contains(Strategies, s) {
+		return "", fmt.Errorf("unknown strategy %q, wanted one of %v", name, Strategies)
+	}
+	return s, nil
+}
+
+// Rank orders the guesses by the Strategy of the recommender for the scores they would get against the possible words.
+// Ties are broken by more entropy, then by preferring guesses that could be the answer.
+func (r Recommender) Rank(possible, all words.Words) []Recommendation {
+	return r.RankBoards([]words.Words{possible}, all)
+}
+
+// RankBoards orders the guesses by the Strategy of the recommender for the scores they would get against the possible words of each board.
+// The boards are independent, so the information and outcomes from each board are added together.
+// The largest number of words left is the most that are left on any board.
+// Without FromAll, the guesses are the words that are possible on any board.
+func (r Recommender) RankBoards(possibles []words.Words, all words.Words) []Recommendation {
+	boardAnswers := make([][]string, 0, len(possibles))
+	union := make(words.Words)
+	for _, possible := range possibles {
+		if len(possible) == 0 {
+			continue
+		}
+		boardAnswers = append(boardAnswers, sortedWords(possible))
+		for w := range possible {
+			union[w] = struct{}{}
+		}
+	}
+	if len(boardAnswers) == 0 {
+		return nil
+	}
+	guesses := sortedWords(union)
+	var others []string
+	if r.FromAll {
+		guesses = guesses[:0]
+		for _, w := range sortedWords(all) {
+			if _, ok := union[w]; ok {
+				guesses = append(guesses, w)
+			} else {
+				others = append(others, w)
+			}
+		}
+	}
+	if r.MaxScores > 0 {
+		numAnswers := 0
+		for _, answers := range boardAnswers {
+			numAnswers += len(answers)
+		}
+		n := max(1, r.MaxScores/numAnswers)
+		guesses = sample(guesses, n)
+		others = sample(others, n-len(guesses))
+	}
+	guesses = append(guesses, others...)
+	recommendations := make([]Recommendation, len(guesses))
+	for i, g := range guesses {
+		_, ok := union[g]
+		rec := Recommendation{
+			Guess:    guess.Guess(g),
+			Possible: ok,
+		}
+		for _, answers := range boardAnswers {
+			sp := newSpread(g, answers, r.Priors)
+			rec.Entropy += sp.entropy
+			rec.Largest = max(rec.Largest, sp.largest)
+			rec.Outcomes += sp.outcomes
+		}
+		recommendations[i] = rec
+	}
+	less := recommendationLess
+	if r.Strategy == Minimax {
+		less = minimaxLess
+	}
+	slices.SortStableFunc(recommendations, less)
+	if r.Count > 0 && r.Count < len(recommendations) {
+		recommendations = recommendations[:r.Count]
+	}
+	return recommendations
+}
+
+// spread describes how the scores of a guess split up the answers
+type spread struct {
+	entropy  float64
+	largest  int
+	outcomes int
+}
+
+// bucket is the answers that get the same score from a guess
+type bucket struct {
+	weight float64
+	count  int
+}
+
+// newSpread calculates the expected information, in bits, of the distribution of scores the guess has against the answers,
+// and the sizes of the groups of answers that get each score.  Each answer is weighted by the priors for the entropy.
+func newSpread(g string, answers []string, priors *words.Frequencies) spread {
+	buckets := make(map[score.Score]bucket)
+	total := 0.0
+	for _, a := range answers {
+		weight := priors.Weight(a)
+		s := score.Compute(g, a)
+		b := buckets[s]
+		b.weight += weight
+		b.count++
+		buckets[s] = b
+		total += weight
+	}
+	sp := spread{
+		outcomes: len(buckets),
+	}
+	weights := make([]float64, 0, len(buckets))
+	for _, b := range buckets {
+		weights = append(weights, b.weight)
+		sp.largest = max(sp.largest, b.count)
+	}
+	slices.Sort(weights) // sum in a consistent order so equal distributions have equal entropies
+	for _, weight := range weights {
+		p := weight / total
+		sp.entropy -= p * math.Log2(p)
+	}
+	return sp
+}
+
+// sample keeps n evenly spaced words, keeping all of them if there are not more than n
+func sample(words []string, n int) []string {
+	if len(words) <= n {
+		return words
+	}
+	s := make([]string, n)
+	for i := range s {
+		s[i] = words[i*len(words)/n]
+	}
+	return s
+}
+
+// sortedWords creates a sorted slice of the words
+func sortedWords(m words.Words) []string {
+	s := make([]string, 0, len(m))
+	for w := range m {
+		s = append(s, w)
+	}
+	slices.Sort(s)
+	return s
+}
+
+// recommendationLess orders recommendations by descending entropy, preferring possible words
+func recommendationLess(a, b Recommendation) int {
+	switch {
+	case a.Entropy != b.Entropy:
+		return cmp.Compare(b.Entropy, a.Entropy)
+	case a.Possible != b.Possible && a.Possible:
+		return -1
+	case a.Possible != b.Possible && b.Possible:
+		return 1
+	}
+	return cmp.Compare(a.Guess, b.Guess)
+}
+
+// minimaxLess orders recommendations by the fewest words left in the worst case, then like recommendationLess
+func minimaxLess(a, b Recommendation) int {
+	if a.Largest != b.Largest {
+		return cmp.Compare(a.Largest, b.Largest)
+	}
+	return recommendationLess(a, b)
+}
@@ -0,0 +1,214 @@
+package recommend
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+)
+
+func TestNewSpread(t *testing.T) {
+	priors, err := words.ParseFrequencies("apple 3\nberry 1", 5)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	tests := []struct {
+		name    string
+		guess   string
+		answers []string
+		priors  *words.Frequencies
+		want    spread
+	}{
+		{"single answer", "apple", []string{"apple"}, nil, spread{0, 1, 1}},
+		{"same score", "zzzzz", []string{"apple", "berry"}, nil, spread{0, 2, 1}},
+		{"two distinct scores", "apple", []string{"apple", "berry"}, nil, spread{1, 1, 2}},
+		{"four distinct scores", "abcde", []string{"abcde", "bacde", "fghij", "aghij"}, nil, spread{2, 1, 4}},
+		{"uneven scores", "abcde", []string{"abcde", "fghij", "fghik"}, nil, spread{math.Log2(3) - 2.0/3, 2, 2}},
+		{"weighted by priors", "apple", []string{"apple", "berry"}, priors, spread{0.75*math.Log2(4.0/3) + 0.25*math.Log2(4), 1, 2}},
+		{"weighted same score", "zzzzz", []string{"apple", "berry"}, priors, spread{0, 2, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := newSpread(test.guess, test.answers, test.priors)
+			if math.Abs(test.want.entropy-got.entropy) > 1e-9 || test.want.largest != got.largest || test.want.outcomes != got.outcomes {
+				t.Errorf("not equal:\nwanted: %+v\ngot:    %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	all := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}, "blitz": {}}
+	possible := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}}
+	priors, err := words.ParseFrequencies("tithe 100\nlithe 100\nbathe 1\nlathe 1", 5)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	tests := []struct {
+		name string
+		Recommender
+		possible  words.Words
+		wantWords []string
+	}{
+		{
+			name: "no possible words",
+		},
+		{
+			name:      "only possible words",
+			possible:  possible,
+			wantWords: []string{"lathe", "lithe", "bathe", "tithe"},
+		},
+		{
+			name:        "all words",
+			Recommender: Recommender{FromAll: true},
+			possible:    possible,
+			wantWords:   []string{"lathe", "lithe", "blitz", "bathe", "tithe"}, // possible words are preferred
+		},
+		{
+			name:        "limited",
+			Recommender: Recommender{FromAll: true, Count: 2},
+			possible:    possible,
+			wantWords:   []string{"lathe", "lithe"},
+		},
+		{
+			name:        "priors",
+			Recommender: Recommender{Priors: priors},
+			possible:    possible,
+			wantWords:   []string{"lathe", "lithe", "tithe", "bathe"}, // tithe splits the likely words better than bathe
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.Recommender.Rank(test.possible, all)
+			var gotWords []string
+			for _, r := range got {
+				gotWords = append(gotWords, string(r.Guess))
+				if _, ok := test.possible[string(r.Guess)]; ok != r.Possible {
+					t.Errorf("wanted %v to be possible: %v", r.Guess, ok)
+				}
+			}
+			if !reflect.DeepEqual(test.wantWords, gotWords) {
+				t.Errorf("not equal: \n wanted: %v \n    got: %v", test.wantWords, gotWords)
+			}
+		})
+	}
+}
+
+func TestRankStrategies(t *testing.T) {
+	possible := words.Words{"joint": {}, "mayor": {}, "stuff": {}, "extra": {}, "trade": {}, "known": {}, "night": {}}
+	tests := []struct {
+		Strategy
+		want Recommendation
+	}{
+		{Entropy, Recommendation{Guess: "extra", Entropy: 2.128085278891394, Largest: 3, Outcomes: 5, Possible: true}},
+		{Minimax, Recommendation{Guess: "mayor", Entropy: 1.9502120649147465, Largest: 2, Outcomes: 4, Possible: true}},
+	}
+	for _, test := range tests {
+		t.Run(string(test.Strategy), func(t *testing.T) {
+			r := Recommender{Strategy: test.Strategy, Count: 1}
+			got := r.Rank(possible, possible)
+			if len(got) != 1 || !reflect.DeepEqual(test.want, got[0]) {
+				t.Errorf("not equal: \n wanted: %v \n    got: %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Strategy
+		wantErr bool
+	}{
+		{"", Entropy, false},
+		{"entropy", Entropy, false},
+		{"minimax", Minimax, false},
+		{"MINIMAX", "", true},
+		{"best", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseStrategy(test.name)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case test.want != got:
+				t.Errorf("wanted %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestRankBoards(t *testing.T) {
+	all := words.Words{"hatch": {}, "batch": {}, "patch": {}, "match": {}, "bumph": {}}
+	tests := []struct {
+		name      string
+		possibles []words.Words
+		want      []Recommendation
+	}{
+		{
+			name: "no boards",
+		},
+		{
+			name:      "only solved boards",
+			possibles: []words.Words{{}, {}},
+		},
+		{
+			name: "two boards",
+			possibles: []words.Words{
+				{"hatch": {}, "batch": {}},
+				{"patch": {}, "match": {}},
+			},
+			want: []Recommendation{
+				{Guess: "bumph", Entropy: 2, Largest: 1, Outcomes: 4},
+				{Guess: "batch", Entropy: 1, Largest: 2, Outcomes: 3, Possible: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := Recommender{FromAll: true, Count: 2}
+			got := r.RankBoards(test.possibles, all)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("not equal: \n wanted: %v \n    got: %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestRankMaxScores(t *testing.T) {
+	all := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}, "blitz": {}}
+	possible := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}}
+	tests := []struct {
+		name        string
+		maxScores   int
+		wantGuesses []guess.Guess
+	}{
+		{"no limit", 0, []guess.Guess{"bathe", "blitz", "lathe", "lithe", "tithe"}},
+		{"enough for all", 20, []guess.Guess{"bathe", "blitz", "lathe", "lithe", "tithe"}},
+		{"possible words first", 16, []guess.Guess{"bathe", "lathe", "lithe", "tithe"}},
+		{"sample of possible words", 8, []guess.Guess{"bathe", "lithe"}},
+		{"at least one guess", 1, []guess.Guess{"bathe"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := Recommender{FromAll: true, MaxScores: test.maxScores}
+			recommendations := r.Rank(possible, all)
+			got := make([]guess.Guess, len(recommendations))
+			for i, rec := range recommendations {
+				got[i] = rec.Guess
+			}
+			slices.Sort(got)
+			if !reflect.DeepEqual(test.wantGuesses, got) {
+				t.Errorf("ranked guesses not equal:\nwanted: %v\ngot:    %v", test.wantGuesses, got)
+			}
+		})
+	}
+}
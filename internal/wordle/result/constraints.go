@@ -0,0 +1,127 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+)
+
+// Constraints are facts about the answer that are known without the guesses that showed them
+type Constraints struct {
+	// Correct has the letters that are known at their positions, with '?' at the other positions: "??r??"
+	Correct string
+	// Almost has patterns of letters that are in the answer, but not at the positions they are at in the pattern,
+	// separated by spaces: "e???? ?a???" is an answer with E that does not start with it and an A that is not second.
+	Almost string
+	// Excluded letters are not in the answer: "st"
+	Excluded string
+}
+
+// unknownLetter is the letter of a position of the constraints that is not known
+const unknownLetter = '?'
+
+// IsEmpty determines if the constraints do not have any facts
+func (c Constraints) IsEmpty() bool {
+	return len(c.Correct) == 0 && len(strings.TrimSpace(c.Almost)) == 0 && len(c.Excluded) == 0
+}
+
+// AddConstraints merges the constraints into the history and trims the words to only include ones that are allowed.
+// The constraints must be added before the results.
+func (h *History) AddConstraints(c Constraints, numLetters int, m *words.Words) error {
+	if err := h.addConstraints(c, numLetters); err != nil {
+		return err
+	}
+	h.Filter(m)
+	return nil
+}
+
+// addConstraints merges the constraints into the history if they do not contradict each other
+func (h *History) addConstraints(c Constraints, numLetters int) error {
+	if c.IsEmpty() {
+		return nil
+	}
+	switch {
+	case len(h.results) != 0:
+		return errors.New("constraints must be added before results")
+	case numLetters <= 0:
+		return fmt.Errorf("wanted positive word length, got %v", numLetters)
+	}
+	correct, err := h.parsePattern("correct letters", c.Correct, numLetters)
+	if err != nil {
+		return err
+	}
+	var almost [][]rune
+	for _, p := range strings.Fields(c.Almost) {
+		letters, err := h.parsePattern("almost letters", p, numLetters)
+		if err != nil {
+			return err
+		}
+		almost = append(almost, letters)
+	}
+	excluded := strings.ToLower(c.Excluded)
+	if !h.alphabet.HasAll(excluded) {
+		return fmt.Errorf("excluded letters must be only the letters %v", h.alphabet.Range())
+	}
+	h.initPositions(numLetters)
+	var usedLetters []rune
+	for i, ch := range correct {
+		if ch != 0 {
+			h.setLetterCorrect(ch, i)
+			usedLetters = append(usedLetters, ch)
+		}
+	}
+	h.mergeRequiredLetters(usedLetters)
+	usedLetters = usedLetters[:0]
+	for _, letters := range almost {
+		for i, ch := range letters {
+			switch {
+			case ch == 0:
+				continue
+			case correct[i] == ch:
+				return fmt.Errorf("%v letter can not be %v and not %v", ordinal(i+1), upper(ch), upper(ch))
+			}
+			h.setLetterAlmost(ch, i)
+			if !strings.ContainsRune(string(usedLetters), ch) {
+				usedLetters = append(usedLetters, ch)
+			}
+		}
+	}
+	h.mergeRequiredLetters(usedLetters)
+	for _, ch := range excluded {
+		if strings.ContainsRune(string(h.almostLetters), ch) {
+			return fmt.Errorf("%v can not be in the answer and excluded from it", upper(ch))
+		}
+		h.setLetterMaxCount(ch, 0)
+		for i := range h.prohibitedLetters {
+			h.setLetterProhibited(ch, i)
+		}
+	}
+	return nil
+}
+
+// parsePattern reads the lowercase letters of the pattern, which are zero at the unknown positions.
+// The pattern must have a letter or '?' at each position, or be empty.
+func (h *History) parsePattern(name, pattern string, numLetters int) ([]rune, error) {
+	letters := make([]rune, numLetters)
+	if len(pattern) == 0 {
+		return letters, nil
+	}
+	pattern = strings.ToLower(pattern)
+	if n := utf8.RuneCountInString(pattern); n != numLetters {
+		return nil, fmt.Errorf("%v %q must be %v letters long, got %v", name, pattern, numLetters, n)
+	}
+	for i, ch := range []rune(pattern) {
+		switch {
+		case ch == unknownLetter:
+			// NOOP
+		case !h.alphabet.Has(ch):
+			return nil, fmt.Errorf("%v must be only the letters %v or %q", name, h.alphabet.Range(), unknownLetter)
+		default:
+			letters[i] = ch
+		}
+	}
+	return letters, nil
+}
@@ -0,0 +1,167 @@
+package result
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+)
+
+func TestHistoryAddConstraints(t *testing.T) {
+	s := []string{"arose", "brace", "crane", "eerie", "rebar", "tiger", "where"}
+	tests := []struct {
+		name string
+		Constraints
+		numLetters int
+		alphabet   *char_set.Alphabet
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name:       "empty",
+			numLetters: 5,
+			want:       s,
+		},
+		{
+			name:        "correct letters",
+			Constraints: Constraints{Correct: "??r??"},
+			numLetters:  5,
+			want:        []string{"eerie"},
+		},
+		{
+			name:        "almost letters",
+			Constraints: Constraints{Almost: "e???? ????e"},
+			numLetters:  5,
+			want:        []string{"rebar", "tiger"},
+		},
+		{
+			name:        "excluded letters",
+			Constraints: Constraints{Excluded: "st"},
+			numLetters:  5,
+			want:        []string{"brace", "crane", "eerie", "rebar", "where"},
+		},
+		{
+			name:        "all",
+			Constraints: Constraints{Correct: "?r???", Almost: "???e?", Excluded: "b"},
+			numLetters:  5,
+			want:        []string{"arose", "crane"},
+		},
+		{
+			name:        "non-english",
+			Constraints: Constraints{Correct: "ñ???"},
+			numLetters:  4,
+			alphabet:    char_set.Spanish,
+			want:        []string{},
+		},
+		{
+			name:        "wrong length",
+			Constraints: Constraints{Correct: "??r?"},
+			numLetters:  5,
+			wantErr:     true,
+		},
+		{
+			name:        "wrong almost length",
+			Constraints: Constraints{Almost: "e???"},
+			numLetters:  5,
+			wantErr:     true,
+		},
+		{
+			name:        "uppercase",
+			Constraints: Constraints{Correct: "?R???", Almost: "???E?", Excluded: "B"},
+			numLetters:  5,
+			want:        []string{"arose", "crane"},
+		},
+		{
+			name:        "uppercase non-english",
+			Constraints: Constraints{Correct: "Ñ???"},
+			numLetters:  4,
+			alphabet:    char_set.Spanish,
+			want:        []string{},
+		},
+		{
+			name:        "bad letter",
+			Constraints: Constraints{Correct: "??1??"},
+			numLetters:  5,
+			wantErr:     true,
+		},
+		{
+			name:        "bad excluded letter",
+			Constraints: Constraints{Excluded: "ñ"},
+			numLetters:  5,
+			wantErr:     true,
+		},
+		{
+			name:        "correct and almost",
+			Constraints: Constraints{Correct: "??r??", Almost: "??r??"},
+			numLetters:  5,
+			wantErr:     true,
+		},
+		{
+			name:        "correct and excluded",
+			Constraints: Constraints{Correct: "??r??", Excluded: "r"},
+			numLetters:  5,
+			wantErr:     true,
+		},
+		{
+			name:        "almost and excluded",
+			Constraints: Constraints{Almost: "e????", Excluded: "e"},
+			numLetters:  5,
+			wantErr:     true,
+		},
+		{
+			name:        "bad length",
+			Constraints: Constraints{Excluded: "e"},
+			wantErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := make(words.Words, len(s))
+			for _, w := range s {
+				m[w] = struct{}{}
+			}
+			h := NewHistory(test.alphabet)
+			err := h.AddConstraints(test.Constraints, test.numLetters, &m)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			default:
+				want := make(words.Words, len(test.want))
+				for _, w := range test.want {
+					want[w] = struct{}{}
+				}
+				if !reflect.DeepEqual(want, m) {
+					t.Errorf("words not equal:\nwanted: %v\ngot:    %v", want, m)
+				}
+			}
+		})
+	}
+}
+
+func TestHistoryAddConstraintsThenResults(t *testing.T) {
+	h := NewHistory(nil)
+	m := words.Words{"crane": {}, "brace": {}, "grace": {}, "trace": {}}
+	if err := h.AddConstraints(Constraints{Correct: "?r???", Excluded: "t"}, 5, &m); err != nil {
+		t.Fatalf("unwanted error adding constraints: %v", err)
+	}
+	if err := h.AddResult(Result{Guess: "brace", Score: "ncccc"}, &m); err != nil {
+		t.Fatalf("unwanted error adding result: %v", err)
+	}
+	if want := (words.Words{"grace": {}}); !reflect.DeepEqual(want, m) {
+		t.Errorf("words not equal:\nwanted: %v\ngot:    %v", want, m)
+	}
+	err := h.AddResult(Result{Guess: "trace", Score: "acccc"}, &m)
+	var ce ContradictionError
+	if !errors.As(err, &ce) {
+		t.Errorf("wanted contradiction error for excluded letter, got %v", err)
+	}
+	if err := h.AddConstraints(Constraints{Excluded: "z"}, 5, &m); err == nil {
+		t.Errorf("wanted error adding constraints after results")
+	}
+}
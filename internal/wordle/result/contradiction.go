@@ -0,0 +1,100 @@
+package result
+
+import (
+	"fmt"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+)
+
+// ContradictionError describes how a result conflicts with the earlier results of a history
+type ContradictionError struct {
+	// Row is the number of the result in the history, starting at 1
+	Row    int
+	Letter rune
+	// Fact is what the result shows about the letter
+	Fact string
+	// Conflict is what the earlier results showed about the letter
+	Conflict string
+}
+
+func (e ContradictionError) Error() string {
+	return fmt.Sprintf("result %v contradicts earlier results for %v: %v, but %v", e.Row, upper(e.Letter), e.Fact, e.Conflict)
+}
+
+// CheckResult ensures the result can be added to the history without contradicting earlier results
+func (h *History) CheckResult(r Result) error {
+	letters := []rune(string(r.Guess))
+	if len(letters) != len(r.Score) {
+		return fmt.Errorf("guess %q and score %q have different lengths", r.Guess, r.Score)
+	}
+	if err := h.ValidateLetters(r.Guess); err != nil {
+		return err
+	}
+	if h.correctLetters == nil {
+		return nil
+	}
+	if want, got := len(h.correctLetters), len(letters); want != got {
+		return fmt.Errorf("result has %v letters, wanted %v", got, want)
+	}
+	contradiction := func(ch rune, fact, conflict string, a ...any) error {
+		return ContradictionError{
+			Row:      len(h.results) + 1,
+			Letter:   ch,
+			Fact:     fmt.Sprintf(fact, a...),
+			Conflict: fmt.Sprintf(conflict, a...),
+		}
+	}
+	usedCounts := make(map[rune]int, len(letters))
+	notCorrect := make(map[rune]bool, len(letters))
+	for i, si := range r.Score {
+		gi := letters[i]
+		correct := h.correctLetters[i]
+		position := ordinal(i + 1)
+		switch {
+		case si == 'c' && correct != 0 && correct != gi:
+			return contradiction(gi, "%[1]v letter is %[2]v", "%[1]v letter is %[3]v in an earlier result", position, upper(gi), upper(correct))
+		case si == 'c' && correct == 0 && h.prohibitedLetters[i].Has(gi):
+			return contradiction(gi, "%[1]v letter is %[2]v", "%[2]v is not the %[1]v letter in an earlier result", position, upper(gi))
+		case si != 'c' && correct == gi:
+			return contradiction(gi, "%[2]v is not the %[1]v letter", "%[1]v letter is %[2]v in an earlier result", position, upper(gi))
+		case si == 'a' && h.excluded(gi):
+			return contradiction(gi, "%[1]v is in the answer", "%[1]v is not in the answer in an earlier result", upper(gi))
+		}
+		switch si {
+		case 'c', 'a':
+			usedCounts[gi]++
+		case 'n':
+			notCorrect[gi] = true
+		}
+	}
+	requiredCounts := letterCounts(h.almostLetters...)
+	for _, ch := range letters {
+		n, required := usedCounts[ch], requiredCounts[ch]
+		maxCount, limited := h.maxLetterCounts[ch]
+		switch {
+		case notCorrect[ch] && n < required:
+			return contradiction(ch, "the answer has exactly %[1]v", "the answer has at least %[2]v in an earlier result", countLetter(n, ch), countLetter(required, ch))
+		case limited && n > maxCount:
+			return contradiction(ch, "the answer has at least %[1]v", "the answer has at most %[2]v in an earlier result", countLetter(n, ch), countLetter(maxCount, ch))
+		}
+	}
+	return nil
+}
+
+// ValidateLetters ensures the guess is only made of letters of the alphabet of the history
+func (h *History) ValidateLetters(g guess.Guess) error {
+	if !h.alphabet.HasAll(string(g)) {
+		return fmt.Errorf("guess must be only the letters %v", h.alphabet.Range())
+	}
+	return nil
+}
+
+// excluded determines if the letter is prohibited at every position it is not known to be correct
+func (h *History) excluded(ch rune) bool {
+	for i, correct := range h.correctLetters {
+		if correct == ch || (correct == 0 && !h.prohibitedLetters[i].Has(ch)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+)
+
+func TestHistoryCheckResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []Result
+		Result
+		wantErr string
+	}{
+		{
+			name:   "first result",
+			Result: Result{Guess: "crane", Score: "ncnnn"},
+		},
+		{
+			name:    "guess and score lengths differ",
+			Result:  Result{Guess: "crane", Score: "ncnn"},
+			wantErr: `guess "crane" and score "ncnn" have different lengths`,
+		},
+		{
+			name:    "result length differs from history",
+			results: []Result{{Guess: "crane", Score: "ncnnn"}},
+			Result:  Result{Guess: "cran", Score: "ncnn"},
+			wantErr: "result has 4 letters, wanted 5",
+		},
+		{
+			name:    "consistent",
+			results: []Result{{Guess: "crane", Score: "ncnnn"}},
+			Result:  Result{Guess: "broth", Score: "ncnan"},
+		},
+		{
+			name:    "different correct letters",
+			results: []Result{{Guess: "crane", Score: "ncnnn"}},
+			Result:  Result{Guess: "blimp", Score: "ncnnn"},
+			wantErr: "result 2 contradicts earlier results for L: 2nd letter is L, but 2nd letter is R in an earlier result",
+		},
+		{
+			name:    "correct letter was prohibited",
+			results: []Result{{Guess: "crane", Score: "nannn"}},
+			Result:  Result{Guess: "eerie", Score: "nnnnc"},
+			wantErr: "result 2 contradicts earlier results for E: 5th letter is E, but E is not the 5th letter in an earlier result",
+		},
+		{
+			name:    "correct letter not marked correct",
+			results: []Result{{Guess: "crane", Score: "ncnnn"}},
+			Result:  Result{Guess: "tries", Score: "nannn"},
+			wantErr: "result 2 contradicts earlier results for R: R is not the 2nd letter, but 2nd letter is R in an earlier result",
+		},
+		{
+			name:    "excluded letter almost correct",
+			results: []Result{{Guess: "crane", Score: "nnnnn"}},
+			Result:  Result{Guess: "exact", Score: "nnann"},
+			wantErr: "result 2 contradicts earlier results for A: A is in the answer, but A is not in the answer in an earlier result",
+		},
+		{
+			name:    "required letter not in answer",
+			results: []Result{{Guess: "crane", Score: "nnnna"}},
+			Result:  Result{Guess: "sleet", Score: "nnnnn"},
+			wantErr: "result 2 contradicts earlier results for E: the answer has exactly 0 E's, but the answer has at least 1 E in an earlier result",
+		},
+		{
+			name:    "too few duplicate letters",
+			results: []Result{{Guess: "geese", Score: "naann"}},
+			Result:  Result{Guess: "sleet", Score: "nnann"},
+			wantErr: "result 2 contradicts earlier results for E: the answer has exactly 1 E, but the answer has at least 2 E's in an earlier result",
+		},
+		{
+			name:    "too many duplicate letters",
+			results: []Result{{Guess: "sleet", Score: "nnann"}},
+			Result:  Result{Guess: "eerie", Score: "aanna"},
+			wantErr: "result 2 contradicts earlier results for E: the answer has at least 3 E's, but the answer has at most 1 E in an earlier result",
+		},
+		{
+			name: "third result",
+			results: []Result{
+				{Guess: "crane", Score: "nnnnn"},
+				{Guess: "moist", Score: "nnnnc"},
+			},
+			Result:  Result{Guess: "fluty", Score: "nncnn"},
+			wantErr: "result 3 contradicts earlier results for T: the answer has exactly 0 T's, but the answer has at least 1 T in an earlier result",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var h History
+			for _, r := range test.results {
+				if err := h.AddResult(r, &words.Words{}); err != nil {
+					t.Fatalf("unwanted error adding %v: %v", r, err)
+				}
+			}
+			err := h.CheckResult(test.Result)
+			switch {
+			case err == nil:
+				if len(test.wantErr) != 0 {
+					t.Errorf("wanted error: %q", test.wantErr)
+				}
+			case err.Error() != test.wantErr:
+				t.Errorf("errors not equal:\nwanted: %q\ngot:    %q", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHistoryAddResultContradiction(t *testing.T) {
+	m := words.Words{"boast": {}, "toast": {}}
+	var h History
+	if err := h.AddResult(Result{Guess: "boast", Score: "ncccc"}, &m); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	want := h
+	err := h.AddResult(Result{Guess: "roast", Score: "nnccc"}, &m)
+	var ce ContradictionError
+	switch {
+	case !errors.As(err, &ce):
+		t.Errorf("wanted contradiction error, got %v", err)
+	case ce.Row != 2, ce.Letter != 'o':
+		t.Errorf("wanted row 2 and letter o, got %+v", ce)
+	case want.String() != h.String(), len(want.results) != len(h.results):
+		t.Errorf("history should not change after contradiction: wanted %v, got %v", want, h)
+	case len(m) != 1:
+		t.Errorf("words should not change after contradiction: %v", m)
+	}
+}
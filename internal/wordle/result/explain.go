@@ -0,0 +1,56 @@
+package result
+
+import (
+	"fmt"
+)
+
+// Explain lists the reasons why the word is not allowed by the history.
+// No reasons are returned if the word is allowed.
+func (h *History) Explain(w string) []string {
+	var reasons []string
+	runes := []rune(w)
+	if n := len(h.correctLetters); n != 0 && len(runes) != n {
+		reasons = append(reasons, fmt.Sprintf("must be %v letters long", n))
+		return reasons
+	}
+	for i, ch := range runes {
+		switch {
+		case i >= len(h.correctLetters):
+			// NOOP
+		case h.correctLetters[i] != 0 && h.correctLetters[i] != ch:
+			reasons = append(reasons, fmt.Sprintf("position %v must be '%c'", i+1, h.correctLetters[i]))
+		case h.correctLetters[i] == 0 && h.prohibitedLetters[i].Has(ch):
+			reasons = append(reasons, fmt.Sprintf("'%c' is prohibited at position %v", ch, i+1))
+		}
+	}
+	wordCounts := letterCounts(runes...)
+	for _, ch := range w {
+		n, ok := h.maxLetterCounts[ch]
+		if ok && n > 0 && wordCounts[ch] > n {
+			reasons = append(reasons, fmt.Sprintf("allows only %v", spellCount(n, ch)))
+			wordCounts[ch] = n // only explain each letter once
+		}
+	}
+	requiredCounts := letterCounts(h.almostLetters...)
+	for _, ch := range h.almostLetters {
+		n := requiredCounts[ch]
+		if wordCounts[ch] < n {
+			reasons = append(reasons, fmt.Sprintf("needs %v", spellCount(n, ch)))
+			requiredCounts[ch] = 0 // only explain each letter once
+		}
+	}
+	return reasons
+}
+
+// spellCount spells out small counts of the letter: one 'e', two 'o's, ...
+func spellCount(n int, ch rune) string {
+	numbers := []string{"no", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten"}
+	count := fmt.Sprint(n)
+	if n >= 0 && n < len(numbers) {
+		count = numbers[n]
+	}
+	if n == 1 {
+		return fmt.Sprintf("%v '%c'", count, ch)
+	}
+	return fmt.Sprintf("%v '%c's", count, ch)
+}
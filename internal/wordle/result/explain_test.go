@@ -0,0 +1,97 @@
+package result
+
+import (
+	"reflect"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+)
+
+func TestHistoryExplain(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []Result
+		word    string
+		want    []string
+	}{
+		{
+			name: "no history",
+			word: "apple",
+		},
+		{
+			name:    "allowed",
+			results: []Result{{Guess: "crane", Score: "nnnna"}},
+			word:    "bleep",
+		},
+		{
+			name:    "prohibited letters",
+			results: []Result{{Guess: "crane", Score: "nnnna"}},
+			word:    "bread",
+			want:    []string{"'r' is prohibited at position 2", "'a' is prohibited at position 4"},
+		},
+		{
+			name:    "too short",
+			results: []Result{{Guess: "crane", Score: "nnnna"}},
+			word:    "bed",
+			want:    []string{"must be 5 letters long"},
+		},
+		{
+			name:    "correct letter",
+			results: []Result{{Guess: "plaza", Score: "nncnn"}},
+			word:    "bloom",
+			want:    []string{"'l' is prohibited at position 2", "position 3 must be 'a'", "needs one 'a'"},
+		},
+		{
+			name:    "almost letter",
+			results: []Result{{Guess: "eerie", Score: "annnn"}},
+			word:    "exist",
+			want:    []string{"'e' is prohibited at position 1", "'i' is prohibited at position 3"},
+		},
+		{
+			name:    "duplicate letters",
+			results: []Result{{Guess: "gooey", Score: "nacnn"}},
+			word:    "boast",
+			want:    []string{"'o' is prohibited at position 2", "position 3 must be 'o'", "needs two 'o's"},
+		},
+		{
+			name:    "too many duplicate letters",
+			results: []Result{{Guess: "sleet", Score: "nnann"}},
+			word:    "eerie",
+			want:    []string{"allows only one 'e'"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var h History
+			for _, r := range test.results {
+				if err := h.AddResult(r, &words.Words{}); err != nil {
+					t.Fatalf("unwanted error adding %v: %v", r, err)
+				}
+			}
+			got := h.Explain(test.word)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("not equal:\nwanted: %q\ngot:    %q", test.want, got)
+			}
+			if want, got := len(test.want) == 0, h.allows(test.word); want != got {
+				t.Errorf("explanation does not match allowed: wanted %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestSpellCount(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "no 'e's"},
+		{1, "one 'e'"},
+		{2, "two 'e's"},
+		{11, "11 'e's"},
+	}
+	for _, test := range tests {
+		if got := spellCount(test.n, 'e'); test.want != got {
+			t.Errorf("wanted %q, got %q", test.want, got)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package result
+
+import (
+	"fmt"
+	"strings"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+)
+
+// ValidateHardMode ensures the guess uses all the hints revealed by the history.
+// Correct letters must be used in the same position and almost correct letters must be in the guess.
+func (h *History) ValidateHardMode(g guess.Guess) error {
+	letters := []rune(string(g))
+	for i, ch := range h.correctLetters {
+		if ch != 0 && (i >= len(letters) || letters[i] != ch) {
+			return fmt.Errorf("%v letter must be %v", ordinal(i+1), upper(ch))
+		}
+	}
+	guessCounts := letterCounts(letters...)
+	requiredCounts := letterCounts(h.almostLetters...)
+	for _, ch := range h.almostLetters {
+		n := requiredCounts[ch]
+		switch {
+		case guessCounts[ch] >= n:
+			// NOOP
+		case n == 1:
+			return fmt.Errorf("guess must contain %v", upper(ch))
+		default:
+			return fmt.Errorf("guess must contain %v", countLetter(n, ch))
+		}
+	}
+	return nil
+}
+
+// FilterHardMode removes the words that are not valid guesses in hard mode
+func (h *History) FilterHardMode(m *words.Words) {
+	for w := range *m {
+		if err := h.ValidateHardMode(guess.Guess(w)); err != nil {
+			delete(*m, w)
+		}
+	}
+}
+
+// ordinal formats the positive number with its suffix: 1st, 2nd, 3rd, 4th, ...
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		// NOOP
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%v%v", n, suffix)
+}
+
+// countLetter formats the number of the letter: 0 E's, 1 E, 2 E's, ...
+func countLetter(n int, ch rune) string {
+	if n == 1 {
+		return fmt.Sprintf("%v %v", n, upper(ch))
+	}
+	return fmt.Sprintf("%v %v's", n, upper(ch))
+}
+
+// upper formats the letter in uppercase
+func upper(ch rune) string {
+	return strings.ToUpper(string(ch))
+}
@@ -0,0 +1,113 @@
+package result
+
+import (
+	"reflect"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+)
+
+func TestHistoryValidateHardMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []Result
+		guess.Guess
+		wantErr string
+	}{
+		{
+			name:  "no history",
+			Guess: "zesty",
+		},
+		{
+			name:    "correct letter moved",
+			results: []Result{{Guess: "crane", Score: "ncnnn"}},
+			Guess:   "pious",
+			wantErr: "2nd letter must be R",
+		},
+		{
+			name:    "almost letter missing",
+			results: []Result{{Guess: "crane", Score: "nnnna"}},
+			Guess:   "pious",
+			wantErr: "guess must contain E",
+		},
+		{
+			name:    "duplicate almost letter missing",
+			results: []Result{{Guess: "geese", Score: "naann"}},
+			Guess:   "trend",
+			wantErr: "guess must contain 2 E's",
+		},
+		{
+			name:    "almost letter in same position",
+			results: []Result{{Guess: "crane", Score: "nnnna"}},
+			Guess:   "shine", // the letter is not required to move
+		},
+		{
+			name: "multiple results",
+			results: []Result{
+				{Guess: "crane", Score: "nacnn"},
+				{Guess: "chart", Score: "nnccn"},
+			},
+			Guess: "roars",
+		},
+		{
+			name: "multiple results, first correct letter missing",
+			results: []Result{
+				{Guess: "crane", Score: "nacnn"},
+				{Guess: "chart", Score: "nnccn"},
+			},
+			Guess:   "lurid",
+			wantErr: "3rd letter must be A",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var h History
+			for _, r := range test.results {
+				h.mergeResult(r)
+			}
+			err := h.ValidateHardMode(test.Guess)
+			switch {
+			case err == nil:
+				if len(test.wantErr) != 0 {
+					t.Errorf("wanted error: %q", test.wantErr)
+				}
+			case err.Error() != test.wantErr:
+				t.Errorf("errors not equal:\nwanted: %q\ngot:    %q", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHistoryFilterHardMode(t *testing.T) {
+	m := words.Words{"crane": {}, "spare": {}, "roars": {}, "blast": {}, "torus": {}}
+	var h History
+	h.mergeResult(Result{Guess: "crane", Score: "nacnn"})
+	want := words.Words{"crane": {}, "spare": {}, "roars": {}}
+	h.FilterHardMode(&m)
+	if !reflect.DeepEqual(want, m) {
+		t.Errorf("not equal:\nwanted: %v\ngot:    %v", want, m)
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{1, "1st"},
+		{2, "2nd"},
+		{3, "3rd"},
+		{4, "4th"},
+		{11, "11th"},
+		{12, "12th"},
+		{13, "13th"},
+		{21, "21st"},
+		{102, "102nd"},
+	}
+	for _, test := range tests {
+		if got := ordinal(test.n); test.want != got {
+			t.Errorf("wanted %q, got %q", test.want, got)
+		}
+	}
+}
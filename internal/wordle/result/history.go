@@ -10,11 +10,22 @@ import (
 )
 
 type (
-	// History stores the state of multiple results
+	// History stores the state of multiple results.
+	// The length of the words it allows is set by the constraints or the first result.
+	// The zero value allows words of the English alphabet.
 	History struct {
-		correctLetters    [numLetters]rune
+		alphabet          *char_set.Alphabet
+		correctLetters    []rune
 		almostLetters     []rune
-		prohibitedLetters [numLetters]char_set.CharSet
+		prohibitedLetters []char_set.CharSet
+		// maxLetterCounts is the most times each letter can be in the answer, known when a letter is scored as not correct
+		maxLetterCounts map[rune]int
+		results         Results
+	}
+	// LetterCount is the range of the number of times a letter is in the answer
+	LetterCount struct {
+		Min int
+		Max int
 	}
 	// Result is a guess and it's score
 	Result struct {
@@ -23,11 +34,33 @@ type (
 	}
 )
 
-const numLetters = 5
+// NewHistory creates an empty history for words of the letters of the alphabet
+func NewHistory(a *char_set.Alphabet) History {
+	return History{alphabet: a}
+}
+
+// AddResult merges the result into the history and trims the words to only include ones that are allowed.
+// The result is not added if it contradicts the earlier results, returning a ContradictionError.
+func (h *History) AddResult(r Result, m *words.Words) error {
+	if err := h.addResult(r); err != nil {
+		return err
+	}
+	h.Filter(m)
+	return nil
+}
 
-// addResult merges the result into the history and trims the words to only include ones that are allowed
-func (h *History) AddResult(r Result, m *words.Words) {
+// addResult merges the result into the history if it does not contradict the earlier results
+func (h *History) addResult(r Result) error {
+	if err := h.CheckResult(r); err != nil {
+		return err
+	}
 	h.mergeResult(r)
+	h.results = append(h.results, r)
+	return nil
+}
+
+// Filter trims the words to only include ones that are allowed
+func (h *History) Filter(m *words.Words) {
 	for w := range *m {
 		if !h.allows(w) {
 			delete(*m, w)
@@ -37,25 +70,37 @@ func (h *History) AddResult(r Result, m *words.Words) {
 
 // mergeResult merges the result into the history
 func (h *History) mergeResult(r Result) {
+	letters := []rune(string(r.Guess))
+	h.initPositions(len(letters))
 	var usedLetters []rune
-	prohibitedCounts := make(map[rune]int, 26)
+	usedCounts := make(map[rune]int, len(letters))
+	notCorrect := make(map[rune]bool, len(letters))
+	prohibited := make(map[rune]bool, len(letters))
 	for i, si := range r.Score {
-		gi := rune(r.Guess[i])
+		gi := letters[i]
 		switch si {
 		case 'c':
 			h.setLetterCorrect(gi, i)
 			usedLetters = append(usedLetters, gi)
+			usedCounts[gi]++
 		case 'a':
 			h.setLetterAlmost(gi, i)
 			usedLetters = append(usedLetters, gi)
-			prohibitedCounts[gi]--
+			usedCounts[gi]++
+			prohibited[gi] = false // the letter is somewhere else
 		case 'n':
 			h.setLetterProhibited(gi, i)
-			prohibitedCounts[gi]++
+			notCorrect[gi] = true
+			if _, ok := prohibited[gi]; !ok {
+				prohibited[gi] = true
+			}
 		}
 	}
-	for ch, prohibitedCount := range prohibitedCounts {
-		if prohibitedCount > 0 {
+	for ch := range notCorrect {
+		h.setLetterMaxCount(ch, usedCounts[ch]) // the other copies of the letter are not in the answer
+	}
+	for ch, isProhibited := range prohibited {
+		if isProhibited {
 			for i := range h.prohibitedLetters {
 				h.setLetterProhibited(ch, i)
 			}
@@ -64,6 +109,20 @@ func (h *History) mergeResult(r Result) {
 	h.mergeRequiredLetters(usedLetters)
 }
 
+// initPositions creates the letters of each position for words that are numLetters long, if they have not been created yet
+func (h *History) initPositions(numLetters int) {
+	if h.correctLetters == nil {
+		h.correctLetters = make([]rune, numLetters)
+		h.prohibitedLetters = make([]char_set.CharSet, numLetters)
+		for i := range h.prohibitedLetters {
+			h.prohibitedLetters[i] = h.alphabet.NewCharSet()
+		}
+	}
+	if h.maxLetterCounts == nil {
+		h.maxLetterCounts = make(map[rune]int)
+	}
+}
+
 // setLetterCorrect sets the letter at the index to correct
 func (h *History) setLetterCorrect(ch rune, index int) {
 	h.correctLetters[index] = ch
@@ -80,6 +139,13 @@ func (h *History) setLetterProhibited(ch rune, index int) {
 	h.prohibitedLetters[index].Add(ch)
 }
 
+// setLetterMaxCount limits the number of times the letter can be in the answer
+func (h *History) setLetterMaxCount(ch rune, n int) {
+	if m, ok := h.maxLetterCounts[ch]; !ok || n < m {
+		h.maxLetterCounts[ch] = n
+	}
+}
+
 // mergeRequiredLetters adds required letters from a guess into the required letters.
 // New letters are only added if they were not previously required.
 func (h *History) mergeRequiredLetters(usedLetters []rune) {
@@ -107,15 +173,27 @@ func letterCounts(runes ...rune) map[rune]int {
 
 // allows determines if a word is allowed based on the history (not prohibited)
 func (h *History) allows(w string) bool {
-	letterCounts := make(map[rune]int, numLetters)
-	for i, ch := range w {
+	runes := []rune(w)
+	hasPositions := len(h.correctLetters) != 0
+	if hasPositions && len(runes) != len(h.correctLetters) {
+		return false
+	}
+	letterCounts := make(map[rune]int, len(runes))
+	for i, ch := range runes {
 		switch {
+		case !hasPositions:
+			// NOOP
 		case h.correctLetters[i] != 0 && h.correctLetters[i] != ch,
 			h.correctLetters[i] == 0 && h.prohibitedLetters[i].Has(ch):
 			return false
 		}
 		letterCounts[ch]++
 	}
+	for ch, n := range letterCounts {
+		if m, ok := h.maxLetterCounts[ch]; ok && n > m {
+			return false // too many of the letter
+		}
+	}
 	for _, ch := range h.almostLetters {
 		n, ok := letterCounts[ch]
 		switch {
@@ -130,6 +208,27 @@ func (h *History) allows(w string) bool {
 	return true
 }
 
+// LetterCounts returns the range of the number of times each letter that has been scored is in the answer.
+// The maximum is limited by the word length and the other letters that are known to be in the answer.
+func (h *History) LetterCounts() map[rune]LetterCount {
+	minCounts := letterCounts(h.almostLetters...)
+	m := make(map[rune]LetterCount, len(minCounts)+len(h.maxLetterCounts))
+	for ch, n := range minCounts {
+		m[ch] = LetterCount{Min: n}
+	}
+	for ch := range h.maxLetterCounts {
+		m[ch] = LetterCount{Min: minCounts[ch]}
+	}
+	for ch, lc := range m {
+		lc.Max = len(h.correctLetters) - len(h.almostLetters) + lc.Min
+		if n, ok := h.maxLetterCounts[ch]; ok && n < lc.Max {
+			lc.Max = n
+		}
+		m[ch] = lc
+	}
+	return m
+}
+
 // String formats the required and prohibited letters to clearly show the state
 func (h History) String() string {
 	correct := make([]rune, len(h.correctLetters))
@@ -149,14 +248,20 @@ func (h History) String() string {
 	for i, cs := range h.prohibitedLetters {
 		prohibited[i] = cs.String()
 	}
+	maxCounts := make(map[string]int, len(h.maxLetterCounts))
+	for ch, n := range h.maxLetterCounts {
+		maxCounts[string(ch)] = n
+	}
 	a := struct {
 		correctLetters    string
 		almostLetters     []string
 		prohibitedLetters []string
+		maxLetterCounts   map[string]int
 	}{
 		string(correct),
 		almost,
 		prohibited,
+		maxCounts,
 	}
 	return fmt.Sprintf("%+v", a)
 }
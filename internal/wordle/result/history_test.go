@@ -21,17 +21,19 @@ func TestHistoryAddResult(t *testing.T) {
 		Score: "nannc",
 	}
 	want := History{
-		correctLetters: [numLetters]rune{
+		correctLetters: []rune{
 			4: 'y',
 		},
 		almostLetters: []rune{'a', 'y'},
-		prohibitedLetters: [numLetters]char_set.CharSet{
+		prohibitedLetters: []char_set.CharSet{
 			newCharSetHelper(t, 'n', 's', 't'),
 			newCharSetHelper(t, 'n', 's', 't', 'a'),
 			newCharSetHelper(t, 'n', 's', 't'),
 			newCharSetHelper(t, 'n', 's', 't'),
 			newCharSetHelper(t, 'n', 's', 't'),
 		},
+		maxLetterCounts: map[rune]int{'n': 0, 's': 0, 't': 0},
+		results:         Results{r},
 	}
 	wantWords := words.Words{
 		"alley": {},
@@ -39,9 +41,11 @@ func TestHistoryAddResult(t *testing.T) {
 	}
 	var h History
 	got := h
-	got.AddResult(r, &allWords)
+	err := got.AddResult(r, &allWords)
 	gotWords := allWords
 	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
 	case !reflect.DeepEqual(want, got):
 		t.Errorf("histories not equal:\nwanted: %+v\ngot:    %+v", want, got)
 	case !reflect.DeepEqual(wantWords, gotWords):
@@ -60,50 +64,54 @@ func TestHistoryMergeResult(t *testing.T) {
 			Guess: "treat",
 			Score: "nannc",
 			want: History{
-				correctLetters: [numLetters]rune{
+				correctLetters: []rune{
 					4: 't',
 				},
 				almostLetters: []rune{'r', 't'},
-				prohibitedLetters: [numLetters]char_set.CharSet{
+				prohibitedLetters: []char_set.CharSet{
 					newCharSetHelper(t, 't', 'e', 'a'),
 					newCharSetHelper(t, 't', 'e', 'a', 'r'),
 					newCharSetHelper(t, 't', 'e', 'a'),
 					newCharSetHelper(t, 't', 'e', 'a'),
 					newCharSetHelper(t, 't', 'e', 'a'),
 				},
+				maxLetterCounts: map[rune]int{'t': 1, 'e': 0, 'a': 0},
 			},
 		},
 		{
 			Guess: "shove",
 			Score: "accnc",
 			want: History{
-				correctLetters: [numLetters]rune{
+				correctLetters: []rune{
 					1: 'h',
 					2: 'o',
 					4: 'e',
 				},
 				almostLetters: []rune{'s', 'h', 'o', 'e'},
-				prohibitedLetters: [numLetters]char_set.CharSet{
+				prohibitedLetters: []char_set.CharSet{
 					newCharSetHelper(t, 's', 'v'),
 					newCharSetHelper(t, 'v'),
 					newCharSetHelper(t, 'v'),
 					newCharSetHelper(t, 'v'),
 					newCharSetHelper(t, 'v'),
 				},
+				maxLetterCounts: map[rune]int{'v': 0},
 			},
 		},
 		{
 			Guess: "dazed",
 			Score: "aanan",
 			want: History{
-				almostLetters: []rune{'d', 'a', 'e'},
-				prohibitedLetters: [numLetters]char_set.CharSet{
+				correctLetters: make([]rune, 5),
+				almostLetters:  []rune{'d', 'a', 'e'},
+				prohibitedLetters: []char_set.CharSet{
 					newCharSetHelper(t, 'z', 'd'),
 					newCharSetHelper(t, 'z', 'a'),
 					newCharSetHelper(t, 'z'),
 					newCharSetHelper(t, 'z', 'e'),
 					newCharSetHelper(t, 'z', 'd'),
 				},
+				maxLetterCounts: map[rune]int{'d': 1, 'z': 0},
 			},
 		},
 	}
@@ -123,16 +131,17 @@ func TestHistoryMergeResult(t *testing.T) {
 
 func TestString(t *testing.T) {
 	h := History{
-		correctLetters: [numLetters]rune{
+		correctLetters: []rune{
 			4: 'q',
 		},
 		almostLetters: []rune{'c', 'a', 'b'},
-		prohibitedLetters: [numLetters]char_set.CharSet{
+		prohibitedLetters: []char_set.CharSet{
 			1: newCharSetHelper(t, 'z', 'e', 'r'),
 			2: newCharSetHelper(t, 'z', 'x', 'a'),
+			4: {},
 		},
 	}
-	want := `{correctLetters:????q almostLetters:[c a b] prohibitedLetters:[[] [erz] [axz] [] []]}`
+	want := `{correctLetters:????q almostLetters:[c a b] prohibitedLetters:[[] [erz] [axz] [] []] maxLetterCounts:map[]}`
 	got := h.String()
 	if want != got {
 		t.Errorf("history Strings not equal:\nwanted: %+v\ngot:    %+v", want, got)
@@ -184,9 +193,11 @@ func TestHistoryAllowsWord(t *testing.T) {
 		}
 		for i, test := range tests {
 			h := History{
-				almostLetters: []rune{'t', 'a', 't'},
-				prohibitedLetters: [numLetters]char_set.CharSet{
+				correctLetters: make([]rune, 5),
+				almostLetters:  []rune{'t', 'a', 't'},
+				prohibitedLetters: []char_set.CharSet{
 					0: newCharSetHelper(t, 'f'),
+					4: {},
 				},
 			}
 			if want, got := test.want, h.allows(test.word); want != got {
@@ -204,11 +215,11 @@ func TestHistoryAllowsWord(t *testing.T) {
 			{
 				name: `result{guess:"treat",score:"nannc"}`,
 				History: History{
-					correctLetters: [numLetters]rune{
+					correctLetters: []rune{
 						4: 't',
 					},
 					almostLetters: []rune{'r', 't'},
-					prohibitedLetters: [numLetters]char_set.CharSet{
+					prohibitedLetters: []char_set.CharSet{
 						newCharSetHelper(t, 't', 'e', 'a'),
 						newCharSetHelper(t, 't', 'e', 'a', 'r'),
 						newCharSetHelper(t, 't', 'e', 'a'),
@@ -222,13 +233,13 @@ func TestHistoryAllowsWord(t *testing.T) {
 			{
 				name: `result{guess:"shove",score: "accnc"}`,
 				History: History{
-					correctLetters: [numLetters]rune{
+					correctLetters: []rune{
 						1: 'h',
 						2: 'o',
 						4: 'e',
 					},
 					almostLetters: []rune{'s', 'h', 'o', 'e'},
-					prohibitedLetters: [numLetters]char_set.CharSet{
+					prohibitedLetters: []char_set.CharSet{
 						newCharSetHelper(t, 's', 'v'),
 						newCharSetHelper(t, 'v'),
 						newCharSetHelper(t, 'v'),
@@ -249,6 +260,72 @@ func TestHistoryAllowsWord(t *testing.T) {
 	})
 }
 
+func TestHistoryAddResultExactLetterCount(t *testing.T) {
+	m := words.Words{"evoke": {}, "bride": {}, "exile": {}}
+	var h History
+	if err := h.AddResult(Result{Guess: "sleet", Score: "nnann"}, &m); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	want := words.Words{"bride": {}} // the answer has exactly one e
+	if !reflect.DeepEqual(want, m) {
+		t.Errorf("words not equal:\nwanted: %v\ngot:    %v", want, m)
+	}
+}
+
+func TestHistoryLetterCounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []Result
+		want    map[rune]LetterCount
+	}{
+		{
+			name: "no results",
+			want: map[rune]LetterCount{},
+		},
+		{
+			name:    "minimum count",
+			results: []Result{{Guess: "crane", Score: "nnnna"}},
+			want: map[rune]LetterCount{
+				'c': {}, 'r': {}, 'a': {}, 'n': {},
+				'e': {Min: 1, Max: 5},
+			},
+		},
+		{
+			name: "exact count",
+			results: []Result{
+				{Guess: "crane", Score: "nnnna"},
+				{Guess: "sleet", Score: "nnann"},
+			},
+			want: map[rune]LetterCount{
+				'c': {}, 'r': {}, 'a': {}, 'n': {}, 's': {}, 'l': {}, 't': {},
+				'e': {Min: 1, Max: 1},
+			},
+		},
+		{
+			name:    "maximum limited by other letters",
+			results: []Result{{Guess: "geese", Score: "cnnac"}},
+			want: map[rune]LetterCount{
+				'g': {Min: 1, Max: 3},
+				'e': {Min: 1, Max: 1},
+				's': {Min: 1, Max: 3},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var h History
+			for _, r := range test.results {
+				if err := h.AddResult(r, &words.Words{}); err != nil {
+					t.Fatalf("unwanted error adding %v: %v", r, err)
+				}
+			}
+			if got := h.LetterCounts(); !reflect.DeepEqual(test.want, got) {
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
+
 func newCharSetHelper(t *testing.T, chars ...rune) char_set.CharSet {
 	t.Helper()
 	var cs char_set.CharSet
@@ -257,3 +334,72 @@ func newCharSetHelper(t *testing.T, chars ...rune) char_set.CharSet {
 	}
 	return cs
 }
+
+func TestHistoryAddComputedResultKeepsAnswer(t *testing.T) {
+	s := []string{"eeexy", "zzzze", "geese", "those", "speed", "abide", "eagle", "allee", "lolly", "holly", "treat", "robot", "sassy", "asses"}
+	for _, answer := range s {
+		allWords := make(words.Words, len(s))
+		for _, w := range s {
+			allWords[w] = struct{}{}
+		}
+		var h History
+		for _, g := range s {
+			r := Result{
+				Guess: guess.Guess(g),
+				Score: score.Compute(g, answer),
+			}
+			if err := h.AddResult(r, &allWords); err != nil {
+				t.Fatalf("unwanted error adding score for %q when answer is %q: %v", g, answer, err)
+			}
+			if _, ok := allWords[answer]; !ok {
+				t.Fatalf("answer %q removed after guessing %q (score %q): %v", answer, g, r.Score, h)
+			}
+		}
+		if want, got := 1, len(allWords); want != got {
+			t.Errorf("wanted only %q to remain after guessing all words, got %v", answer, allWords)
+		}
+	}
+}
+
+func TestHistoryAddResultWordLength(t *testing.T) {
+	allWords := words.Words{"bead": {}, "beds": {}, "bread": {}, "abed": {}}
+	r := Result{
+		Guess: "bard",
+		Score: "canc",
+	}
+	want := words.Words{"bead": {}}
+	var h History
+	if err := h.AddResult(r, &allWords); err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	if !reflect.DeepEqual(want, allWords) {
+		t.Errorf("words not equal after result added to history:\nwanted: %+v\ngot:    %+v", want, allWords)
+	}
+}
+
+func TestHistoryAddResultAlphabet(t *testing.T) {
+	r := Result{
+		Guess: "grüße",
+		Score: "ccncc",
+	}
+	t.Run("german", func(t *testing.T) {
+		allWords := words.Words{"größe": {}, "grüße": {}, "straße": {}}
+		want := words.Words{"größe": {}}
+		h := NewHistory(char_set.German)
+		if err := h.AddResult(r, &allWords); err != nil {
+			t.Fatalf("unwanted error: %v", err)
+		}
+		if !reflect.DeepEqual(want, allWords) {
+			t.Errorf("words not equal after result added to history:\nwanted: %+v\ngot:    %+v", want, allWords)
+		}
+		if want, got := []string{"'ü' is prohibited at position 3"}, h.Explain("grüße"); !reflect.DeepEqual(want, got) {
+			t.Errorf("explanations not equal:\nwanted: %q\ngot:    %q", want, got)
+		}
+	})
+	t.Run("english", func(t *testing.T) {
+		var h History
+		if err := h.AddResult(r, &words.Words{}); err == nil {
+			t.Errorf("wanted error adding result with letters that are not in the alphabet")
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package result
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+// Results is a list of results that can be saved and shared.
+// The text form has a line for each result with the guess and score separated by a space: "crane nnnna".
+// The binary form starts with the word length followed by a byte for each letter of each result.
+type Results []Result
+
+// scoreLetters are the letters of a score, in the order they are encoded in binary
+const scoreLetters = "can"
+
+// MarshalText encodes the results as lines of guesses and scores
+func (rs Results) MarshalText() ([]byte, error) {
+	var b strings.Builder
+	for i, r := range rs {
+		if err := r.validate(utf8.RuneCountInString(string(rs[0].Guess))); err != nil {
+			return nil, fmt.Errorf("result %v: %w", i+1, err)
+		}
+		fmt.Fprintf(&b, "%v %v\n", r.Guess, r.Score)
+	}
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText decodes the results from guesses and scores separated by whitespace
+func (rs *Results) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+	if len(fields)%2 != 0 {
+		return fmt.Errorf("wanted a score for each guess, got %v words", len(fields))
+	}
+	results := make(Results, 0, len(fields)/2)
+	for i := 0; i < len(fields); i += 2 {
+		r := Result{
+			Guess: guess.New(fields[i]),
+			Score: score.New(fields[i+1]),
+		}
+		if err := r.validate(utf8.RuneCountInString(fields[0])); err != nil {
+			return fmt.Errorf("result %v: %w", len(results)+1, err)
+		}
+		results = append(results, r)
+	}
+	*rs = results
+	return nil
+}
+
+// MarshalBinary encodes the results compactly, combining each letter of a guess with its score in a byte.
+// Only guesses of the letters a-z can be encoded.
+func (rs Results) MarshalBinary() ([]byte, error) {
+	if len(rs) == 0 {
+		return nil, nil
+	}
+	numLetters := len(rs[0].Guess)
+	if numLetters > 255 {
+		return nil, fmt.Errorf("words must be at most 255 letters long")
+	}
+	data := make([]byte, 1, 1+len(rs)*numLetters)
+	data[0] = byte(numLetters)
+	for i, r := range rs {
+		if err := r.validate(numLetters); err != nil {
+			return nil, fmt.Errorf("result %v: %w", i+1, err)
+		}
+		if !char_set.English.HasAll(string(r.Guess)) {
+			return nil, fmt.Errorf("result %v: only guesses of the letters a-z can be encoded", i+1)
+		}
+		for j := range numLetters {
+			letter := r.Guess[j] - 'a'
+			s := strings.IndexByte(scoreLetters, r.Score[j])
+			data = append(data, letter*byte(len(scoreLetters))+byte(s))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes the results from the compact binary form
+func (rs *Results) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*rs = nil
+		return nil
+	}
+	numLetters := int(data[0])
+	data = data[1:]
+	if numLetters == 0 || len(data)%numLetters != 0 {
+		return fmt.Errorf("wanted results of %v letters, got %v extra bytes", numLetters, len(data))
+	}
+	results := make(Results, 0, len(data)/numLetters)
+	for len(data) != 0 {
+		g := make([]byte, numLetters)
+		s := make([]byte, numLetters)
+		for j, b := range data[:numLetters] {
+			letter, scoreIndex := b/byte(len(scoreLetters)), b%byte(len(scoreLetters))
+			if letter >= 26 {
+				return fmt.Errorf("result %v: invalid letter", len(results)+1)
+			}
+			g[j] = 'a' + letter
+			s[j] = scoreLetters[scoreIndex]
+		}
+		results = append(results, Result{Guess: guess.Guess(g), Score: score.Score(s)})
+		data = data[numLetters:]
+	}
+	*rs = results
+	return nil
+}
+
+// ShareCode encodes the results as a short url-safe string
+func (rs Results) ShareCode() (string, error) {
+	data, err := rs.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// ParseShareCode decodes results from a share code
+func ParseShareCode(code string) (Results, error) {
+	data, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("decoding share code: %w", err)
+	}
+	var rs Results
+	if err := rs.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("decoding share code: %w", err)
+	}
+	return rs, nil
+}
+
+// validate ensures the result has numLetters lowercase letters and a valid score
+func (r Result) validate(numLetters int) error {
+	var anyWord words.Words
+	if err := r.Guess.Validate(anyWord, numLetters); err != nil {
+		return err
+	}
+	for _, ch := range r.Guess {
+		if !unicode.IsLower(ch) {
+			return fmt.Errorf("guess must be only lowercase letters")
+		}
+	}
+	return r.Score.Validate(numLetters)
+}
+
+// Results returns a copy of the results that have been added to the history
+func (h *History) Results() Results {
+	return append(Results(nil), h.results...)
+}
+
+// MarshalText encodes the results of the history
+func (h History) MarshalText() ([]byte, error) {
+	return h.results.MarshalText()
+}
+
+// UnmarshalText replaces the history with one made by adding the results from the text
+func (h *History) UnmarshalText(text []byte) error {
+	var rs Results
+	if err := rs.UnmarshalText(text); err != nil {
+		return err
+	}
+	return h.setResults(rs)
+}
+
+// MarshalBinary encodes the results of the history compactly
+func (h History) MarshalBinary() ([]byte, error) {
+	return h.results.MarshalBinary()
+}
+
+// UnmarshalBinary replaces the history with one made by adding the results from the data
+func (h *History) UnmarshalBinary(data []byte) error {
+	var rs Results
+	if err := rs.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	return h.setResults(rs)
+}
+
+// setResults replaces the history with one made by adding the results
+func (h *History) setResults(rs Results) error {
+	h2 := NewHistory(h.alphabet)
+	for _, r := range rs {
+		if err := h2.addResult(r); err != nil {
+			return err
+		}
+	}
+	*h = h2
+	return nil
+}
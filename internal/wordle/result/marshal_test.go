@@ -0,0 +1,181 @@
+package result
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResultsText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    Results
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: Results{},
+		},
+		{
+			name: "two results",
+			text: "crane nnnna\nsleet nnann\n",
+			want: Results{
+				{Guess: "crane", Score: "nnnna"},
+				{Guess: "sleet", Score: "nnann"},
+			},
+		},
+		{
+			name:    "missing score",
+			text:    "crane nnnna\nsleet\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid score",
+			text:    "crane nnnnx\n",
+			wantErr: true,
+		},
+		{
+			name:    "different lengths",
+			text:    "crane nnnna\nfort nnnn\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid letter",
+			text:    "cr4ne nnnnn\n",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Results
+			err := got.UnmarshalText([]byte(test.text))
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			default:
+				text, err := got.MarshalText()
+				switch {
+				case err != nil:
+					t.Errorf("unwanted marshal error: %v", err)
+				case test.text != string(text):
+					t.Errorf("marshalled text not equal:\nwanted: %q\ngot:    %q", test.text, text)
+				}
+			}
+		})
+	}
+}
+
+func TestResultsShareCodeAlphabet(t *testing.T) {
+	rs := Results{{Guess: "grüße", Score: "ccncc"}}
+	if _, err := rs.MarshalText(); err != nil {
+		t.Errorf("unwanted error marshalling text of german results: %v", err)
+	}
+	if _, err := rs.ShareCode(); err == nil {
+		t.Errorf("wanted error creating share code of german results")
+	}
+}
+
+func TestResultsMarshalTextInvalid(t *testing.T) {
+	rs := Results{{Guess: "crane", Score: "nnnna"}, {Guess: "fort", Score: "nnnn"}}
+	if _, err := rs.MarshalText(); err == nil {
+		t.Errorf("wanted error marshalling results of different lengths")
+	}
+}
+
+func TestResultsShareCode(t *testing.T) {
+	tests := []struct {
+		name string
+		Results
+		want string
+	}{
+		{"empty", nil, ""},
+		{"one result", Results{{Guess: "abz", Score: "can"}}, "AwAETQ"},
+		{"two results", Results{{Guess: "crane", Score: "nnnna"}, {Guess: "sleet", Score: "nnann"}}, "BQg1AikNOCMNDjs"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.Results.ShareCode()
+			switch {
+			case err != nil:
+				t.Fatalf("unwanted error: %v", err)
+			case test.want != got:
+				t.Errorf("wanted %q, got %q", test.want, got)
+			}
+			rs, err := ParseShareCode(got)
+			switch {
+			case err != nil:
+				t.Errorf("unwanted parse error: %v", err)
+			case !reflect.DeepEqual(test.Results, rs):
+				t.Errorf("parsed results not equal:\nwanted: %v\ngot:    %v", test.Results, rs)
+			}
+		})
+	}
+}
+
+func TestParseShareCodeInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+	}{
+		{"not base64", "!!!"},
+		{"zero length", "AA"},
+		{"extra bytes", "BQAAAAAAAA"},
+		{"invalid letter", "Af8"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := ParseShareCode(test.code); err == nil {
+				t.Errorf("wanted error")
+			}
+		})
+	}
+}
+
+func TestHistoryMarshal(t *testing.T) {
+	var h History
+	rs := Results{{Guess: "crane", Score: "nnnna"}, {Guess: "sleet", Score: "nnann"}}
+	for _, r := range rs {
+		if err := h.addResult(r); err != nil {
+			t.Fatalf("unwanted error: %v", err)
+		}
+	}
+	text, err := h.MarshalText()
+	if err != nil {
+		t.Fatalf("unwanted text marshal error: %v", err)
+	}
+	data, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unwanted binary marshal error: %v", err)
+	}
+	var fromText, fromBinary History
+	if err := fromText.UnmarshalText(text); err != nil {
+		t.Fatalf("unwanted text unmarshal error: %v", err)
+	}
+	if err := fromBinary.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unwanted binary unmarshal error: %v", err)
+	}
+	switch {
+	case !reflect.DeepEqual(h, fromText):
+		t.Errorf("history from text not equal:\nwanted: %v\ngot:    %v", h, fromText)
+	case !reflect.DeepEqual(h, fromBinary):
+		t.Errorf("history from binary not equal:\nwanted: %v\ngot:    %v", h, fromBinary)
+	case !reflect.DeepEqual(rs, h.Results()):
+		t.Errorf("results not equal:\nwanted: %v\ngot:    %v", rs, h.Results())
+	}
+}
+
+func TestHistoryUnmarshalContradiction(t *testing.T) {
+	h := History{}
+	if err := h.UnmarshalText([]byte("crane nnnna sleet nnnnn")); err == nil {
+		t.Errorf("wanted contradiction error")
+	}
+	if len(h.results) != 0 {
+		t.Errorf("wanted history to be unchanged, got %v", h)
+	}
+}
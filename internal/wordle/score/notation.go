@@ -0,0 +1,133 @@
+package score
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Notation is a way of writing scores with other letters than c, a and n
+type Notation struct {
+	Name string
+	// Correct, Almost and Not are the letters that mark each kind of score letter.
+	// The first letter of each is used to describe the notation.
+	Correct, Almost, Not string
+}
+
+var (
+	// Letters is the canonical notation that all scores are normalized to
+	Letters = Notation{Name: "letters", Correct: "c", Almost: "a", Not: "n"}
+	// Digits marks letters by how correct they are: 210
+	Digits = Notation{Name: "digits", Correct: "2", Almost: "1", Not: "0"}
+	// Colors marks letters by the first letter of their tile color: green, yellow and gray
+	Colors = Notation{Name: "colors", Correct: "g", Almost: "y", Not: "-"}
+	// Marks uses punctuation: +?.
+	Marks = Notation{Name: "marks", Correct: "+", Almost: "?", Not: "."}
+	// Emoji uses the squares of a shared grid, including high contrast colors
+	Emoji = Notation{Name: "emoji", Correct: "🟩🟧", Almost: "🟨🟦", Not: "⬛⬜"}
+)
+
+var (
+	// notations are the registered notations, in the order they were registered
+	notations []Notation
+	// notationLetters maps the letters of all registered notations to the canonical letters
+	notationLetters = make(map[rune]rune)
+)
+
+func init() {
+	for _, n := range []Notation{Letters, Digits, Colors, Marks, Emoji} {
+		if err := RegisterNotation(n); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterNotation allows scores to be written in the notation.
+// Notations should be registered before any scores are read.
+// An error is returned if a letter of the notation is already used for a different kind of score letter.
+func RegisterNotation(n Notation) error {
+	n.Correct, n.Almost, n.Not = strings.ToLower(n.Correct), strings.ToLower(n.Almost), strings.ToLower(n.Not)
+	letters := make(map[rune]rune, len(n.Correct)+len(n.Almost)+len(n.Not))
+	for _, l := range []struct {
+		marks     string
+		canonical rune
+	}{
+		{n.Correct, 'c'},
+		{n.Almost, 'a'},
+		{n.Not, 'n'},
+	} {
+		if len(l.marks) == 0 {
+			return fmt.Errorf("notation %v has no letters for %c", n.Name, l.canonical)
+		}
+		for _, ch := range l.marks {
+			existing, ok := notationLetters[ch]
+			if !ok {
+				existing, ok = letters[ch]
+			}
+			if ok && existing != l.canonical {
+				return fmt.Errorf("notation %v uses %q for %c, but it is already used for %c", n.Name, ch, l.canonical, existing)
+			}
+			letters[ch] = l.canonical
+		}
+	}
+	for ch, canonical := range letters {
+		notationLetters[ch] = canonical
+	}
+	notations = append(notations, n)
+	return nil
+}
+
+// Notations returns the registered notations
+func Notations() []Notation {
+	return append([]Notation(nil), notations...)
+}
+
+// String describes the notation by the first letter of each kind of score letter
+func (n Notation) String() string {
+	first := func(s string) string {
+		for _, ch := range s {
+			return string(ch)
+		}
+		return ""
+	}
+	return first(n.Correct) + first(n.Almost) + first(n.Not)
+}
+
+// Normalize rewrites the score in the canonical c, a and n letters.
+// Letters that are not in any notation are kept so the score can be validated.
+func (s Score) Normalize() Score {
+	var b strings.Builder
+	for _, ch := range strings.ToLower(string(s)) {
+		switch canonical, ok := notationLetters[ch]; {
+		case ok:
+			b.WriteRune(canonical)
+		case ch == '\uFE0F':
+			// NOOP (emoji presentation selectors are dropped)
+		default:
+			b.WriteRune(ch)
+		}
+	}
+	return Score(b.String())
+}
+
+// Pattern is a regular expression that matches one letter of any registered notation
+func Pattern() string {
+	var letters []string
+	for _, n := range notations {
+		for _, ch := range n.Correct + n.Almost + n.Not {
+			letters = append(letters, regexp.QuoteMeta(string(ch)))
+		}
+	}
+	return strings.Join(letters, "|")
+}
+
+// notationHelp describes the letters of the registered notations
+func notationHelp() string {
+	others := make([]string, 0, len(notations))
+	for _, n := range notations {
+		if n.Name != Letters.Name {
+			others = append(others, strings.ToUpper(n.String()))
+		}
+	}
+	return fmt.Sprintf("C, A, N (or another notation: %v)", strings.Join(others, ", "))
+}
@@ -0,0 +1,113 @@
+package score
+
+import (
+	"maps"
+	"regexp"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		Score
+		want Score
+	}{
+		{"canonical", "canac", "canac"},
+		{"uppercase", "CANAC", "canac"},
+		{"digits", "21012", "canac"},
+		{"colors", "gy-yg", "canac"},
+		{"uppercase colors", "GY-YG", "canac"},
+		{"marks", "+?.?+", "canac"},
+		{"emoji", "🟩🟨⬛🟦🟧", "canac"},
+		{"mixed", "2y.a🟩", "canac"},
+		{"unknown letters kept", "cax", "cax"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.Score.Normalize(); test.want != got {
+				t.Errorf("wanted %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateNotation(t *testing.T) {
+	tests := []struct {
+		Score
+		wantOk bool
+	}{
+		{"21012", true},
+		{"gy-yg", true},
+		{"+?.?+", true},
+		{"🟩🟨⬛🟦🟧", true},
+		{"2101", false},
+		{"2101x", false},
+	}
+	for _, test := range tests {
+		if err := test.Score.Validate(5); test.wantOk != (err == nil) {
+			t.Errorf("%q: wanted valid: %v, got error: %v", test.Score, test.wantOk, err)
+		}
+	}
+}
+
+func TestRegisterNotation(t *testing.T) {
+	savedNotations, savedLetters := Notations(), maps.Clone(notationLetters)
+	t.Cleanup(func() {
+		notations, notationLetters = savedNotations, savedLetters
+	})
+	tests := []struct {
+		name string
+		Notation
+		wantOk bool
+	}{
+		{"new letters", Notation{Name: "checks", Correct: "V", Almost: "~", Not: "x"}, true},
+		{"same meaning", Notation{Name: "binary", Correct: "c", Almost: "1", Not: "x"}, true},
+		{"conflict", Notation{Name: "swapped", Correct: "a", Almost: "c", Not: "n"}, false},
+		{"conflict within", Notation{Name: "repeated", Correct: "!", Almost: "!", Not: "_"}, false},
+		{"missing letters", Notation{Name: "empty", Correct: "!"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			n := len(notations)
+			err := RegisterNotation(test.Notation)
+			switch {
+			case !test.wantOk:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+				if n != len(notations) {
+					t.Errorf("wanted notation to not be registered")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			}
+		})
+	}
+	if want, got := Score("can"), New("v~X"); want != got {
+		t.Errorf("registered notation not normalized: wanted %q, got %q", want, got)
+	}
+	if _, ok := notationLetters['!']; ok {
+		t.Errorf("letters of invalid notations should not be registered")
+	}
+}
+
+func TestPattern(t *testing.T) {
+	re := regexp.MustCompile(`^(` + Pattern() + `){5}$`)
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"canac", true},
+		{"21012", true},
+		{"gy-yg", true},
+		{"+?.?+", true},
+		{"🟩🟨⬛🟦🟧", true},
+		{"canax", false},
+		{"cana", false},
+	}
+	for _, test := range tests {
+		if got := re.MatchString(test.s); test.want != got {
+			t.Errorf("%q: wanted match: %v, got %v", test.s, test.want, got)
+		}
+	}
+}
@@ -4,25 +4,30 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
+	"unicode/utf8"
 )
 
-// Score is a <<numLetters>>-letter string made up of {c,a,n}.
+// Score is a string made up of {c,a,n} with a letter for each letter of a guess.
 // * The letter c indicates that a letter from a guess is in the correct position.
 // * The letter a indicates that a letter from a guess is in the answer, but in a different position.
 // * The letter n indicates that a letter from a guess is not anywhere in the answer.
 type Score string
 
-const AllCorrect Score = "ccccc"
+// AllCorrect creates the score of a guess that is the answer
+func AllCorrect(numLetters int) Score {
+	return Score(strings.Repeat("c", numLetters))
+}
 
 // New reads the next word from the reader.  It may be invalid.
+// The letters of other notations are replaced with the canonical letters.
 func New(word string) Score {
-	word = strings.ToLower(word)
 	s := Score(word)
-	return s
+	return s.Normalize()
 }
 
 // Scan prompts for a score on the ReadWriter until a valid one is given or an io error occurs
-func Scan(rw io.ReadWriter) (*Score, error) {
+func Scan(rw io.ReadWriter, numLetters int) (*Score, error) {
 	for {
 		fmt.Fprintf(rw, "Enter score: ")
 		var word string
@@ -30,7 +35,7 @@ func Scan(rw io.ReadWriter) (*Score, error) {
 			return nil, fmt.Errorf("scanning guess: %v", err)
 		}
 		s := New(word)
-		if err := s.Validate(); err != nil {
+		if err := s.Validate(numLetters); err != nil {
 			fmt.Fprintf(rw, "%v\n", err)
 			continue
 		}
@@ -38,9 +43,129 @@ func Scan(rw io.ReadWriter) (*Score, error) {
 	}
 }
 
-// Validate ensures the score is <<numLetters>> letters long and consists only of the {c,a,n} letters
-func (s Score) Validate() error {
-	const numLetters = 5
+// Compute grades the guess against the answer.
+// Letters in the correct position are marked first.
+// The remaining duplicate letters of the guess are only marked as almost correct as many times as they are left in the answer, from left to right.
+// Words of ASCII letters are graded without allocating memory when they are short, because guesses are scored against many answers to rank them.
+func Compute(guess, answer string) Score {
+	if !isASCII(guess) || !isASCII(answer) {
+		return computeRunes([]rune(guess), []rune(answer))
+	}
+	var buf [maxSharedLetters]byte
+	marks := buf[:0]
+	if len(guess) > len(buf) {
+		marks = make([]byte, 0, len(guess))
+	}
+	marks = marks[:len(guess)]
+	var unused [utf8.RuneSelf]int32
+	for i := range len(guess) {
+		switch {
+		case i < len(answer) && guess[i] == answer[i]:
+			marks[i] = 'c'
+		case i < len(answer):
+			unused[answer[i]]++
+		}
+	}
+	for i := range len(guess) {
+		switch {
+		case marks[i] == 'c':
+			// NOOP
+		case unused[guess[i]] > 0:
+			marks[i] = 'a'
+			unused[guess[i]]--
+		default:
+			marks[i] = 'n'
+		}
+	}
+	return newScore(marks)
+}
+
+// computeRunes grades the guess against the answer like Compute, for words with letters that are not ASCII
+func computeRunes(g, a []rune) Score {
+	marks := make([]byte, len(g))
+	unused := make(map[rune]int, len(a))
+	for i := range len(g) {
+		switch {
+		case i < len(a) && g[i] == a[i]:
+			marks[i] = 'c'
+		case i < len(a):
+			unused[a[i]]++
+		}
+	}
+	for i := range len(g) {
+		switch {
+		case marks[i] == 'c':
+			// NOOP
+		case unused[g[i]] > 0:
+			marks[i] = 'a'
+			unused[g[i]]--
+		default:
+			marks[i] = 'n'
+		}
+	}
+	return newScore(marks)
+}
+
+// isASCII determines if every byte of the word is an ASCII character
+func isASCII(w string) bool {
+	for i := range len(w) {
+		if w[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// maxSharedLetters is the length of the longest scores that are shared instead of allocated
+const maxSharedLetters = 8
+
+// sharedScores are every score of each length up to maxSharedLetters, indexed by the base-3 number of their letters: n=0, a=1, c=2.
+// The scores of a length are created when they are first needed.
+var sharedScores [maxSharedLetters + 1]func() []Score
+
+func init() {
+	for n := range sharedScores {
+		sharedScores[n] = sync.OnceValue(func() []Score {
+			count := 1
+			for range n {
+				count *= 3
+			}
+			scores := make([]Score, count)
+			marks := make([]byte, n)
+			for code := range scores {
+				c := code
+				for i := n - 1; i >= 0; i-- {
+					marks[i] = "nac"[c%3]
+					c /= 3
+				}
+				scores[code] = Score(marks)
+			}
+			return scores
+		})
+	}
+}
+
+// newScore converts the marks to a score, sharing the scores of short words
+func newScore(marks []byte) Score {
+	if len(marks) > maxSharedLetters {
+		return Score(marks)
+	}
+	code := 0
+	for _, m := range marks {
+		code *= 3
+		switch m {
+		case 'a':
+			code++
+		case 'c':
+			code += 2
+		}
+	}
+	return sharedScores[len(marks)]()[code]
+}
+
+// Validate ensures the score is numLetters letters long and consists only of the {c,a,n} letters, or the letters of another registered notation
+func (s Score) Validate(numLetters int) error {
+	s = s.Normalize()
 	if len(s) != numLetters {
 		return fmt.Errorf("score must be %v letters long", numLetters)
 	}
@@ -49,7 +174,7 @@ func (s Score) Validate() error {
 		case 'c', 'a', 'n':
 			// NOOP
 		default:
-			return fmt.Errorf("must be only the following letters: C, A, N")
+			return fmt.Errorf("must be only the following letters: %v", notationHelp())
 		}
 	}
 	return nil
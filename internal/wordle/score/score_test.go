@@ -7,7 +7,7 @@ import (
 )
 
 func TestAllCorrectValid(t *testing.T) {
-	if err := AllCorrect.Validate(); err != nil {
+	if err := AllCorrect(5).Validate(5); err != nil {
 		t.Errorf("all correct string is not valid: %v", err)
 	}
 }
@@ -34,7 +34,7 @@ func TestScan(t *testing.T) {
 		},
 		{
 			in:      "nac apple canac",
-			wantOut: "Enter score: score must be 5 letters long\nEnter score: must be only the following letters: C, A, N\nEnter score: ",
+			wantOut: "Enter score: score must be 5 letters long\nEnter score: must be only the following letters: C, A, N (or another notation: 210, GY-, +?., 🟩🟨⬛)\nEnter score: ",
 			want:    "canac",
 		},
 	}
@@ -44,7 +44,7 @@ func TestScan(t *testing.T) {
 			Reader: bufio.NewReader(strings.NewReader(test.in)),
 			Writer: bufio.NewWriter(&buf),
 		}
-		got, gotErr := Scan(rw)
+		got, gotErr := Scan(rw, 5)
 		rw.Flush()
 		switch {
 		case test.wantErr:
@@ -76,10 +76,75 @@ func TestScoreAllCorrect(t *testing.T) {
 			Score: "ccccc",
 			want:  true,
 		},
+		{
+			Score: "cccc", // wrong length
+		},
 	}
 	for i, test := range tests {
-		if want, got := test.want, test.Score == AllCorrect; want != got {
+		if want, got := test.want, test.Score == AllCorrect(5); want != got {
 			t.Errorf("test %v: allCorrect values not equal for %q: wanted %v, got %v", i, test.Score, want, got)
 		}
 	}
 }
+
+func TestValidateLength(t *testing.T) {
+	tests := []struct {
+		Score
+		numLetters int
+		wantOk     bool
+	}{
+		{"cann", 4, true},
+		{"canna", 4, false},
+		{"cannac", 6, true},
+	}
+	for _, test := range tests {
+		t.Run(string(test.Score), func(t *testing.T) {
+			err := test.Score.Validate(test.numLetters)
+			switch {
+			case err != nil:
+				if test.wantOk {
+					t.Errorf("unwanted error: %v", err)
+				}
+			case !test.wantOk:
+				t.Errorf("wanted error")
+			}
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		guess  string
+		answer string
+		want   Score
+	}{
+		{"apple", "apple", "ccccc"},
+		{"crane", "slate", "nncnc"},
+		{"speed", "abide", "nnana"}, // only one e is in the answer
+		{"geese", "those", "nnncc"}, // the correct e is marked before the other e's
+		{"lolly", "holly", "ncccc"},
+		{"allee", "eagle", "aanac"},
+		{"eeexx", "xxxxe", "annca"},
+		{"grüße", "größe", "ccncc"},
+		{"niños", "señor", "nncca"},
+		{"abracadabra", "cadabraabra", "aaacaaacccc"},
+		{"crane", "cranes", "ccccc"},
+		{"cranes", "crane", "cccccn"},
+	}
+	for _, test := range tests {
+		t.Run(test.guess+"-"+test.answer, func(t *testing.T) {
+			if got := Compute(test.guess, test.answer); test.want != got {
+				t.Errorf("wanted %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestComputeAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		Compute("geese", "those")
+	})
+	if allocs != 0 {
+		t.Errorf("wanted scores of short ASCII words to be computed without allocating, got %v allocations", allocs)
+	}
+}
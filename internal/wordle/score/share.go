@@ -0,0 +1,139 @@
+package score
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Share is the emoji grid of a game that is shared after it is played, with the header: "Wordle 1,234 4/6"
+type Share struct {
+	// Number is the puzzle number, zero if there is no header
+	Number int
+	// Guesses is the number of guesses used to find the answer, zero if the answer was not found
+	Guesses int
+	// MaxGuesses is the number of guesses allowed, zero if there is no header
+	MaxGuesses int
+	// HardMode is set when the header is marked with a star
+	HardMode bool
+	// Scores has a score for each row of the grid
+	Scores []Score
+}
+
+// shareHeaderRE matches the first line of a shared grid: "Wordle 1,234 4/6*"
+var shareHeaderRE = regexp.MustCompile(`^Wordle\s+([\d,. ]+?)\s+(\d+|X)/(\d+)(\*?)$`)
+
+// ParseEmoji reads a row of a shared grid as a score
+func ParseEmoji(row string) (Score, error) {
+	var b strings.Builder
+	for _, ch := range row {
+		switch {
+		case strings.ContainsRune(Emoji.Correct+Emoji.Almost+Emoji.Not, ch):
+			b.WriteRune(ch)
+		case unicode.IsSpace(ch), ch == '\uFE0F':
+			// NOOP (whitespace and emoji presentation selectors are ignored)
+		default:
+			return "", fmt.Errorf("%q is not a score square", ch)
+		}
+	}
+	if b.Len() == 0 {
+		return "", fmt.Errorf("no score squares")
+	}
+	return Score(b.String()).Normalize(), nil
+}
+
+// ParseShare reads a shared grid.  The header is optional, but all other non-empty lines must be rows of the same length.
+func ParseShare(text string) (*Share, error) {
+	var sh Share
+	hasHeader := false
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case len(line) == 0:
+			continue
+		case !hasHeader && len(sh.Scores) == 0 && strings.HasPrefix(line, "Wordle"):
+			if err := sh.parseHeader(line); err != nil {
+				return nil, err
+			}
+			hasHeader = true
+			continue
+		}
+		s, err := ParseEmoji(line)
+		if err != nil {
+			return nil, fmt.Errorf("row %v: %w", len(sh.Scores)+1, err)
+		}
+		if len(sh.Scores) != 0 && len(s) != len(sh.Scores[0]) {
+			return nil, fmt.Errorf("row %v: wanted %v squares, got %v", len(sh.Scores)+1, len(sh.Scores[0]), len(s))
+		}
+		sh.Scores = append(sh.Scores, s)
+	}
+	switch {
+	case len(sh.Scores) == 0:
+		return nil, fmt.Errorf("no rows in shared grid")
+	case hasHeader && sh.Guesses != 0 && sh.Guesses != len(sh.Scores):
+		return nil, fmt.Errorf("header has %v guesses, but there are %v rows", sh.Guesses, len(sh.Scores))
+	case hasHeader && sh.Guesses == 0 && len(sh.Scores) != sh.MaxGuesses:
+		return nil, fmt.Errorf("header has no answer after %v guesses, but there are %v rows", sh.MaxGuesses, len(sh.Scores))
+	}
+	for i, s := range sh.Scores {
+		last := i == len(sh.Scores)-1
+		switch allCorrect := s == AllCorrect(len(s)); {
+		case allCorrect && !last:
+			return nil, fmt.Errorf("row %v: only the last row can be all correct", i+1)
+		case hasHeader && last && allCorrect != (sh.Guesses != 0):
+			return nil, fmt.Errorf("row %v: the last row must be all correct only if the answer was found", i+1)
+		}
+	}
+	return &sh, nil
+}
+
+// parseHeader reads the puzzle number and guess counts of the header line
+func (sh *Share) parseHeader(line string) error {
+	m := shareHeaderRE.FindStringSubmatch(line)
+	if m == nil {
+		return fmt.Errorf("invalid header: %q", line)
+	}
+	number := strings.NewReplacer(",", "", ".", "", " ", "").Replace(m[1])
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return fmt.Errorf("parsing puzzle number: %w", err)
+	}
+	sh.Number = n
+	if m[2] != "X" {
+		sh.Guesses, _ = strconv.Atoi(m[2]) // the pattern only matches digits
+	}
+	sh.MaxGuesses, _ = strconv.Atoi(m[3])
+	sh.HardMode = len(m[4]) != 0
+	return nil
+}
+
+// Allows determines if the answer could have produced every row of the grid from some of the guesses
+func (sh Share) Allows(answer string, guesses []string) bool {
+	missing := make(map[Score]bool, len(sh.Scores))
+	for _, s := range sh.Scores {
+		missing[s] = true
+	}
+	for _, g := range guesses {
+		delete(missing, Compute(g, answer))
+		if len(missing) == 0 {
+			return true
+		}
+	}
+	return len(missing) == 0
+}
+
+// Paths lists the guesses that could have produced each row of the grid if the answer is the word
+func (sh Share) Paths(answer string, guesses []string) [][]string {
+	paths := make([][]string, len(sh.Scores))
+	for _, g := range guesses {
+		s := Compute(g, answer)
+		for i, si := range sh.Scores {
+			if s == si {
+				paths[i] = append(paths[i], g)
+			}
+		}
+	}
+	return paths
+}
@@ -0,0 +1,171 @@
+package score
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEmoji(t *testing.T) {
+	tests := []struct {
+		name    string
+		row     string
+		want    Score
+		wantErr bool
+	}{
+		{"dark mode", "⬛🟨⬛🟩🟩", "nancc", false},
+		{"light mode", "⬜🟨⬜🟩🟩", "nancc", false},
+		{"high contrast", "⬛🟦⬛🟧🟧", "nancc", false},
+		{"presentation selectors", "⬛️🟨⬛️🟩🟩", "nancc", false},
+		{"letters", "nancc", "", true},
+		{"empty", "", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseEmoji(test.row)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case test.want != got:
+				t.Errorf("wanted %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNewEmoji(t *testing.T) {
+	if want, got := Score("nancc"), New("⬛🟨⬜🟧🟩"); want != got {
+		t.Errorf("wanted %q, got %q", want, got)
+	}
+}
+
+func TestParseShare(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    *Share
+		wantErr bool
+	}{
+		{
+			name: "solved",
+			text: "Wordle 1,234 3/6\n\n⬛🟨⬛⬛⬛\n⬛⬛🟩🟨⬛\n🟩🟩🟩🟩🟩\n",
+			want: &Share{
+				Number:     1234,
+				Guesses:    3,
+				MaxGuesses: 6,
+				Scores:     []Score{"nannn", "nncan", "ccccc"},
+			},
+		},
+		{
+			name: "hard mode",
+			text: "Wordle 987 1/6*\n🟩🟩🟩🟩🟩",
+			want: &Share{
+				Number:     987,
+				Guesses:    1,
+				MaxGuesses: 6,
+				HardMode:   true,
+				Scores:     []Score{"ccccc"},
+			},
+		},
+		{
+			name: "not solved",
+			text: "Wordle 1.001 X/2\n⬛⬛⬛⬛⬛\n🟩🟩🟩🟩⬛",
+			want: &Share{
+				Number:     1001,
+				MaxGuesses: 2,
+				Scores:     []Score{"nnnnn", "ccccn"},
+			},
+		},
+		{
+			name: "no header",
+			text: "⬛🟨⬛\n🟩🟩🟩",
+			want: &Share{
+				Scores: []Score{"nan", "ccc"},
+			},
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+		{
+			name:    "invalid header",
+			text:    "Wordle one 1/6\n🟩🟩🟩🟩🟩",
+			wantErr: true,
+		},
+		{
+			name:    "wrong number of guesses",
+			text:    "Wordle 1 2/6\n🟩🟩🟩🟩🟩",
+			wantErr: true,
+		},
+		{
+			name:    "wrong number of unsolved guesses",
+			text:    "Wordle 1 X/6\n⬛⬛⬛⬛⬛",
+			wantErr: true,
+		},
+		{
+			name:    "solved early",
+			text:    "🟩🟩🟩🟩🟩\n⬛⬛⬛⬛⬛",
+			wantErr: true,
+		},
+		{
+			name:    "not solved at end",
+			text:    "Wordle 1 1/6\n⬛⬛⬛⬛⬛",
+			wantErr: true,
+		},
+		{
+			name:    "rows of different lengths",
+			text:    "⬛⬛⬛⬛\n🟩🟩🟩🟩🟩",
+			wantErr: true,
+		},
+		{
+			name:    "extra text",
+			text:    "🟩🟩🟩🟩🟩\nhttps://example.com",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseShare(test.text)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %+v\ngot:    %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestShareAllows(t *testing.T) {
+	guesses := []string{"lathe", "bathe", "tithe", "ghost"}
+	sh := Share{Scores: []Score{"nnccc", "ccccc"}}
+	tests := []struct {
+		answer string
+		want   bool
+	}{
+		{"lathe", true}, // tithe
+		{"tithe", true}, // lathe or bathe
+		{"ghost", false},
+	}
+	for _, test := range tests {
+		if got := sh.Allows(test.answer, guesses); test.want != got {
+			t.Errorf("%v: wanted %v, got %v", test.answer, test.want, got)
+		}
+	}
+}
+
+func TestSharePaths(t *testing.T) {
+	guesses := []string{"lathe", "bathe", "tithe", "ghost"}
+	sh := Share{Scores: []Score{"nanna", "ncccc", "ccccc"}}
+	want := [][]string{{"ghost"}, {"lathe"}, {"bathe"}}
+	if got := sh.Paths("bathe", guesses); !reflect.DeepEqual(want, got) {
+		t.Errorf("not equal:\nwanted: %v\ngot:    %v", want, got)
+	}
+}
@@ -0,0 +1,206 @@
+package solver
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/recommend"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/result"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/score"
+)
+
+type (
+	// Strategy picks the next guess from the possible answers and all the allowed words
+	Strategy func(possible, all words.Words) guess.Guess
+	// Solver plays games of wordle with a strategy
+	Solver struct {
+		Strategy Strategy
+		// Opener is the first guess of each game.  The strategy picks it if it is empty.
+		Opener guess.Guess
+		// MaxGuesses is the number of guesses allowed to find the answer
+		MaxGuesses int
+		// Parallel is the number of games to play at once.  The number of CPUs is used if it is not positive.
+		Parallel int
+		// Alphabet is the letters of the words.  The English alphabet is used if it is nil.
+		Alphabet *char_set.Alphabet
+	}
+	// Game is the guesses played to find the answer
+	Game struct {
+		Answer  string
+		Guesses []guess.Guess
+		Solved  bool
+	}
+	// Report summarizes the games played for many answers
+	Report struct {
+		Games int
+		// Histogram counts the solved games by the number of guesses they took, indexed by the guess count
+		Histogram []int
+		Failures  []string
+		// Worst are the answers of the solved games that took the most guesses
+		Worst        []string
+		WorstGuesses int
+		Average      float64
+	}
+)
+
+// Strategies are the named strategies that can be played
+var Strategies = map[string]Strategy{
+	"entropy":     Recommended(recommend.Recommender{}),
+	"entropy-all": Recommended(recommend.Recommender{FromAll: true}),
+	"minimax":     Recommended(recommend.Recommender{Strategy: recommend.Minimax}),
+	"minimax-all": Recommended(recommend.Recommender{Strategy: recommend.Minimax, FromAll: true}),
+	"first":       First,
+}
+
+// Recommended creates a strategy that plays the best recommendation
+func Recommended(r recommend.Recommender) Strategy {
+	r.Count = 1
+	return func(possible, all words.Words) guess.Guess {
+		recommendations := r.Rank(possible, all)
+		if len(recommendations) == 0 {
+			return ""
+		}
+		return recommendations[0].Guess
+	}
+}
+
+// First is a strategy that guesses the alphabetically first possible word
+func First(possible, all words.Words) guess.Guess {
+	var first string
+	for w := range possible {
+		if len(first) == 0 || w < first {
+			first = w
+		}
+	}
+	return guess.Guess(first)
+}
+
+// Play guesses words until the answer is found or the guesses run out.
+// The possible words start as the answers, but guesses can be any of the words.
+// An error is returned if a guess has letters that are not in the alphabet of the solver.
+func (s Solver) Play(answer string, answers, all words.Words) (*Game, error) {
+	g := Game{
+		Answer: answer,
+	}
+	possible := answers.Copy()
+	h := result.NewHistory(s.Alphabet)
+	for len(g.Guesses) < s.MaxGuesses {
+		next := s.Opener
+		if len(g.Guesses) != 0 || len(next) == 0 {
+			next = s.Strategy(*possible, all)
+		}
+		if len(next) == 0 {
+			break // no possible words
+		}
+		g.Guesses = append(g.Guesses, next)
+		sc := score.Compute(string(next), answer)
+		if sc == score.AllCorrect(len(sc)) {
+			g.Solved = true
+			break
+		}
+		r := result.Result{
+			Guess: next,
+			Score: sc,
+		}
+		if err := h.AddResult(r, possible); err != nil {
+			return nil, fmt.Errorf("playing %v: %w", answer, err)
+		}
+	}
+	return &g, nil
+}
+
+// Benchmark plays a game for every answer and summarizes the results.
+// The first error of a game is returned.
+func (s Solver) Benchmark(answers, all words.Words) (*Report, error) {
+	if len(s.Opener) == 0 && s.MaxGuesses > 0 {
+		s.Opener = s.Strategy(answers, all) // the first guess is the same for every game
+	}
+	sortedAnswers := make([]string, 0, len(answers))
+	for w := range answers {
+		sortedAnswers = append(sortedAnswers, w)
+	}
+	slices.Sort(sortedAnswers)
+	games := make([]Game, len(sortedAnswers))
+	errs := make([]error, len(sortedAnswers))
+	n := s.Parallel
+	if n <= 0 {
+		n = runtime.NumCPU()
+	}
+	var wg sync.WaitGroup
+	for j := range n {
+		wg.Go(func() {
+			for i := j; i < len(sortedAnswers); i += n {
+				g, err := s.Play(sortedAnswers[i], answers, all)
+				if err != nil {
+					errs[i] = err
+					continue
+				}
+				games[i] = *g
+			}
+		})
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	r := newReport(games)
+	return &r, nil
+}
+
+// newReport summarizes the games
+func newReport(games []Game) Report {
+	r := Report{
+		Games: len(games),
+	}
+	solvedCount, guessCount := 0, 0
+	for _, g := range games {
+		n := len(g.Guesses)
+		switch {
+		case !g.Solved:
+			r.Failures = append(r.Failures, g.Answer)
+			continue
+		case n > r.WorstGuesses:
+			r.WorstGuesses = n
+			r.Worst = []string{g.Answer}
+		case n == r.WorstGuesses:
+			r.Worst = append(r.Worst, g.Answer)
+		}
+		for len(r.Histogram) <= n {
+			r.Histogram = append(r.Histogram, 0)
+		}
+		r.Histogram[n]++
+		solvedCount++
+		guessCount += n
+	}
+	if solvedCount > 0 {
+		r.Average = float64(guessCount) / float64(solvedCount)
+	}
+	return r
+}
+
+// Print writes the summary of the report
+func (r Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "games: %v\n", r.Games)
+	fmt.Fprintf(w, "average guesses: %.4f\n", r.Average)
+	fmt.Fprintf(w, "guess distribution:\n")
+	for n, count := range r.Histogram {
+		if n == 0 {
+			continue
+		}
+		fmt.Fprintf(w, " %2v: %v\n", n, count)
+	}
+	fmt.Fprintf(w, "failures: %v\n", len(r.Failures))
+	if len(r.Failures) > 0 {
+		fmt.Fprintf(w, "failed words: %v\n", strings.Join(r.Failures, ","))
+	}
+	fmt.Fprintf(w, "worst words (%v guesses): %v\n", r.WorstGuesses, strings.Join(r.Worst, ","))
+}
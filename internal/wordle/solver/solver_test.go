@@ -0,0 +1,201 @@
+package solver
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	words "github.com/jacobpatterson1549/wordle-cheater"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/char_set"
+	"github.com/jacobpatterson1549/wordle-cheater/internal/wordle/guess"
+)
+
+func TestFirst(t *testing.T) {
+	possible := words.Words{"lithe": {}, "bathe": {}, "tithe": {}}
+	if want, got := guess.Guess("bathe"), First(possible, nil); want != got {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+}
+
+func TestStrategies(t *testing.T) {
+	all := words.Words{"hatch": {}, "batch": {}, "patch": {}, "match": {}, "bumph": {}}
+	possible := words.Words{"hatch": {}, "batch": {}, "patch": {}, "match": {}}
+	tests := []struct {
+		name string
+		want guess.Guess
+	}{
+		{"entropy", "batch"},
+		{"entropy-all", "bumph"}, // scores differently against each possible word
+		{"first", "batch"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, ok := Strategies[test.name]
+			if !ok {
+				t.Fatalf("missing strategy")
+			}
+			if got := s(possible, all); test.want != got {
+				t.Errorf("wanted %v, got %v", test.want, got)
+			}
+		})
+	}
+	t.Run("none possible", func(t *testing.T) {
+		for name, s := range Strategies {
+			if got := s(words.Words{}, all); len(got) != 0 {
+				t.Errorf("%v: wanted no guess, got %v", name, got)
+			}
+		}
+	})
+}
+
+func TestPlay(t *testing.T) {
+	all := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}, "blitz": {}}
+	tests := []struct {
+		name string
+		Solver
+		answer string
+		want   Game
+	}{
+		{
+			name:   "opener is answer",
+			Solver: Solver{Strategy: First, Opener: "tithe", MaxGuesses: 6},
+			answer: "tithe",
+			want:   Game{Answer: "tithe", Guesses: []guess.Guess{"tithe"}, Solved: true},
+		},
+		{
+			name:   "strategy opener",
+			Solver: Solver{Strategy: First, MaxGuesses: 6},
+			answer: "lithe",
+			want:   Game{Answer: "lithe", Guesses: []guess.Guess{"bathe", "lithe"}, Solved: true},
+		},
+		{
+			name:   "too many guesses",
+			Solver: Solver{Strategy: First, MaxGuesses: 1},
+			answer: "lithe",
+			want:   Game{Answer: "lithe", Guesses: []guess.Guess{"bathe"}},
+		},
+		{
+			name:   "unknown answer",
+			Solver: Solver{Strategy: First, MaxGuesses: 6},
+			answer: "zesty",
+			want:   Game{Answer: "zesty", Guesses: []guess.Guess{"bathe"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.Solver.Play(test.answer, all, all)
+			switch {
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, *got):
+				t.Errorf("not equal: \n wanted: %+v \n    got: %+v", test.want, *got)
+			}
+		})
+	}
+}
+
+func TestBenchmark(t *testing.T) {
+	all := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}}
+	s := Solver{
+		Strategy:   First,
+		MaxGuesses: 2,
+		Parallel:   2,
+	}
+	want := Report{
+		Games:        4,
+		Histogram:    []int{0, 1, 2},
+		Failures:     []string{"tithe"},
+		Worst:        []string{"lathe", "lithe"},
+		WorstGuesses: 2,
+		Average:      5.0 / 3,
+	}
+	got, err := s.Benchmark(all, all)
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case !reflect.DeepEqual(want, *got):
+		t.Errorf("not equal: \n wanted: %+v \n    got: %+v", want, *got)
+	}
+}
+
+func TestReportPrint(t *testing.T) {
+	r := Report{
+		Games:        4,
+		Histogram:    []int{0, 1, 2},
+		Failures:     []string{"tithe"},
+		Worst:        []string{"lathe", "lithe"},
+		WorstGuesses: 2,
+		Average:      5.0 / 3,
+	}
+	want := "games: 4\n" +
+		"average guesses: 1.6667\n" +
+		"guess distribution:\n" +
+		"  1: 1\n" +
+		"  2: 2\n" +
+		"failures: 1\n" +
+		"failed words: tithe\n" +
+		"worst words (2 guesses): lathe,lithe\n"
+	var sb strings.Builder
+	r.Print(&sb)
+	if got := sb.String(); want != got {
+		t.Errorf("not equal: \n wanted: %q \n    got: %q", want, got)
+	}
+}
+
+func TestBenchmarkAnswers(t *testing.T) {
+	answers := words.Words{"lathe": {}, "lithe": {}}
+	all := words.Words{"bathe": {}, "lathe": {}, "tithe": {}, "lithe": {}}
+	s := Solver{
+		Strategy:   First,
+		MaxGuesses: 6,
+		Parallel:   1,
+	}
+	want := Report{
+		Games:        2,
+		Histogram:    []int{0, 1, 1},
+		Worst:        []string{"lithe"},
+		WorstGuesses: 2,
+		Average:      1.5,
+	}
+	got, err := s.Benchmark(answers, all)
+	switch {
+	case err != nil:
+		t.Errorf("unwanted error: %v", err)
+	case !reflect.DeepEqual(want, *got):
+		t.Errorf("not equal: \n wanted: %+v \n    got: %+v", want, *got)
+	}
+}
+
+func TestBenchmarkAlphabet(t *testing.T) {
+	all := words.Words{"große": {}, "größe": {}, "grüße": {}, "güter": {}}
+	tests := []struct {
+		name     string
+		alphabet *char_set.Alphabet
+		wantErr  bool
+	}{
+		{"english", nil, true},
+		{"german", char_set.German, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := Solver{
+				Strategy:   First,
+				MaxGuesses: 6,
+				Alphabet:   test.alphabet,
+			}
+			got, err := s.Benchmark(all, all)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case len(got.Failures) != 0:
+				t.Errorf("wanted every game to be solved, got failures: %v", got.Failures)
+			case got.Games != len(all):
+				t.Errorf("wanted %v games, got %v", len(all), got.Games)
+			}
+		})
+	}
+}
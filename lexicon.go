@@ -0,0 +1,145 @@
+package words
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/dawg"
+)
+
+// Lexicon is a word list that is parsed once and indexed by word length and by the prefixes of the words.
+// It is never modified after it is created, so it can be shared between goroutines.
+type Lexicon struct {
+	// byLength has the sorted lowercase words of each length
+	byLength map[int][]string
+	// trie has the lowercase words, to find the words that start with a prefix
+	trie *dawg.DAWG
+	// uppercase has the first word of each length that is not lowercase
+	uppercase map[int]string
+	// size is the number of distinct words
+	size int
+}
+
+// NewLexicon parses and indexes the words of the text, which are separated by whitespace (spaces/newlines).
+func NewLexicon(text string) *Lexicon {
+	return newLexicon(strings.Fields(text), nil)
+}
+
+// NewLexiconDAWG indexes the words of the binary form of a DAWG.
+// The DAWG is used to find the words that start with a prefix if all of its words are lowercase, so the words are not parsed from text.
+func NewLexiconDAWG(data []byte) (*Lexicon, error) {
+	var d dawg.DAWG
+	if err := d.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("decoding words: %w", err)
+	}
+	return newLexicon(d.Words(), &d), nil
+}
+
+// newLexicon indexes the words.  The trie is rebuilt from the lowercase words if it is nil or has other words.
+func newLexicon(allWords []string, trie *dawg.DAWG) *Lexicon {
+	l := Lexicon{
+		byLength:  make(map[int][]string),
+		uppercase: make(map[int]string),
+	}
+	seen := make(map[string]struct{})
+	var lowercase []string
+	for _, w := range allWords {
+		if _, ok := seen[w]; ok {
+			continue
+		}
+		seen[w] = struct{}{}
+		l.size++
+		n := utf8.RuneCountInString(w)
+		if w != strings.ToLower(w) {
+			if _, ok := l.uppercase[n]; !ok {
+				l.uppercase[n] = w
+			}
+			continue
+		}
+		l.byLength[n] = append(l.byLength[n], w)
+		lowercase = append(lowercase, w)
+	}
+	for _, s := range l.byLength {
+		slices.Sort(s)
+	}
+	if trie == nil || trie.Len() != len(lowercase) {
+		trie = dawg.New(lowercase)
+	}
+	l.trie = trie
+	return &l
+}
+
+// Len is the number of distinct words, including the ones that are not lowercase
+func (l *Lexicon) Len() int {
+	return l.size
+}
+
+// Words creates the words that are numLetters long.
+// An error is returned if any of the words are not lowercase.
+func (l *Lexicon) Words(numLetters int) (*Words, error) {
+	if numLetters <= 0 {
+		return nil, fmt.Errorf("wanted positive word length, got %v", numLetters)
+	}
+	if w, ok := l.uppercase[numLetters]; ok {
+		return nil, fmt.Errorf("wanted all words to be lowercase, got %q", w)
+	}
+	s := l.byLength[numLetters]
+	m := make(Words, len(s))
+	for _, w := range s {
+		m[w] = struct{}{}
+	}
+	return &m, nil
+}
+
+// Lists creates the guesses and answers that are numLetters long, using the words of the lexicon as guesses.
+// All of the guesses are used as answers if the answers lexicon is empty.
+func (l *Lexicon) Lists(answers *Lexicon, numLetters int) (*Lists, error) {
+	guesses, err := l.Words(numLetters)
+	if err != nil {
+		return nil, fmt.Errorf("loading guesses: %w", err)
+	}
+	if answers.Len() == 0 {
+		lists := Lists{
+			Guesses: *guesses,
+			Answers: *guesses.Copy(),
+		}
+		return &lists, nil
+	}
+	a, err := answers.Words(numLetters)
+	if err != nil {
+		return nil, fmt.Errorf("loading answers: %w", err)
+	}
+	for w := range *a {
+		(*guesses)[w] = struct{}{}
+	}
+	lists := Lists{
+		Guesses: *guesses,
+		Answers: *a,
+	}
+	return &lists, nil
+}
+
+// WithLetters returns the words that are only made of the letters, in order.
+// Letters can be used more than once.
+func (l *Lexicon) WithLetters(letters string) []string {
+	var words []string
+	l.Walk(func(prefix []rune, isWord bool) bool {
+		if !strings.ContainsRune(letters, prefix[len(prefix)-1]) {
+			return false
+		}
+		if isWord {
+			words = append(words, string(prefix))
+		}
+		return true
+	})
+	return words
+}
+
+// Walk calls the function with each prefix of the lowercase words, in order.
+// The function is told if the prefix is a word and returns false to skip the words that start with the prefix.
+// The prefix is reused after the function returns, so it must be copied to be kept.
+func (l *Lexicon) Walk(fn func(prefix []rune, isWord bool) bool) {
+	l.trie.Walk(fn)
+}
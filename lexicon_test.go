@@ -0,0 +1,189 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/dawg"
+)
+
+func TestLexiconLen(t *testing.T) {
+	l := NewLexicon("apple berry apple CHERRY\n\n")
+	if want, got := 3, l.Len(); want != got {
+		t.Errorf("wanted %v distinct words, got %v", want, got)
+	}
+}
+
+func TestLexiconWithLetters(t *testing.T) {
+	l := NewLexicon("a ab abba bad cab dab ñame añejo APPLE")
+	tests := []struct {
+		name    string
+		letters string
+		want    []string
+	}{
+		{"empty", "", nil},
+		{"single", "a", []string{"a"}},
+		{"repeated letters", "ba", []string{"a", "ab", "abba"}},
+		{"unsorted letters", "dcba", []string{"a", "ab", "abba", "bad", "cab", "dab"}},
+		{"no words", "xyz", nil},
+		{"uppercase words ignored", "aple", []string{"a"}},
+		{"non-english", "ñamejo", []string{"a", "añejo", "ñame"}},
+		{"many letters", "abcdefghijklmnopqrstuvwxyz", []string{"a", "ab", "abba", "bad", "cab", "dab"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := l.WithLetters(test.letters)
+			if len(test.want) == 0 && len(got) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("not equal:\nwanted: %q\ngot:    %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestLexiconLists(t *testing.T) {
+	guesses := NewLexicon("apple berry cherry")
+	tests := []struct {
+		name    string
+		answers string
+		want    *Lists
+		wantErr bool
+	}{
+		{
+			name: "guesses are answers",
+			want: &Lists{
+				Guesses: Words{"apple": {}, "berry": {}},
+				Answers: Words{"apple": {}, "berry": {}},
+			},
+		},
+		{
+			name:    "answers are guesses",
+			answers: "mango",
+			want: &Lists{
+				Guesses: Words{"apple": {}, "berry": {}, "mango": {}},
+				Answers: Words{"mango": {}},
+			},
+		},
+		{
+			name:    "uppercase answers",
+			answers: "MANGO",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := guesses.Lists(NewLexicon(test.answers), DefaultNumLetters)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestLexiconListsAnswersCopied(t *testing.T) {
+	l, err := NewLexicon("apple").Lists(NewLexicon(""), DefaultNumLetters)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	delete(l.Answers, "apple")
+	if _, ok := l.Guesses["apple"]; !ok {
+		t.Errorf("wanted guesses to be independent of answers")
+	}
+}
+
+func TestLexiconWordsShared(t *testing.T) {
+	l := NewLexicon("apple berry")
+	a, err := l.Words(DefaultNumLetters)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	delete(*a, "apple")
+	b, err := l.Words(DefaultNumLetters)
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	if _, ok := (*b)["apple"]; !ok {
+		t.Errorf("changing the words changed the lexicon")
+	}
+}
+
+func TestNewLexiconDAWG(t *testing.T) {
+	tests := []struct {
+		name        string
+		words       []string
+		wantLen     int
+		wantWords   []string
+		wantLetters []string
+		wantErr     bool
+	}{
+		{
+			name:        "lowercase",
+			words:       []string{"apple", "berry", "ab"},
+			wantLen:     3,
+			wantWords:   []string{"apple", "berry"},
+			wantLetters: []string{"ab"},
+		},
+		{
+			name:        "uppercase words ignored",
+			words:       []string{"apple", "berry", "AB", "ab"},
+			wantLen:     4,
+			wantWords:   []string{"apple", "berry"},
+			wantLetters: []string{"ab"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := dawg.New(test.words).MarshalBinary()
+			if err != nil {
+				t.Fatalf("unwanted error: %v", err)
+			}
+			l, err := NewLexiconDAWG(data)
+			if err != nil {
+				t.Fatalf("unwanted error: %v", err)
+			}
+			m, err := l.Words(DefaultNumLetters)
+			if err != nil {
+				t.Fatalf("unwanted error: %v", err)
+			}
+			want := make(Words)
+			for _, w := range test.wantWords {
+				want[w] = struct{}{}
+			}
+			switch {
+			case test.wantLen != l.Len():
+				t.Errorf("wanted %v words, got %v", test.wantLen, l.Len())
+			case !reflect.DeepEqual(want, *m):
+				t.Errorf("words not equal:\nwanted: %v\ngot:    %v", want, *m)
+			case !reflect.DeepEqual(test.wantLetters, l.WithLetters("ab")):
+				t.Errorf("words with letters not equal:\nwanted: %v\ngot:    %v", test.wantLetters, l.WithLetters("ab"))
+			}
+		})
+	}
+	if _, err := NewLexiconDAWG([]byte("not a dawg")); err == nil {
+		t.Errorf("wanted error decoding invalid dawg")
+	}
+}
+
+func TestEmbeddedLexicon(t *testing.T) {
+	want := NewLexicon(EmbeddedText())
+	got := EmbeddedLexicon()
+	if want.Len() != got.Len() {
+		t.Errorf("wanted %v words, got %v", want.Len(), got.Len())
+	}
+	for n := 1; n <= 15; n++ {
+		wantWords, wantErr := want.Words(n)
+		gotWords, gotErr := got.Words(n)
+		if !reflect.DeepEqual(wantWords, gotWords) || (wantErr == nil) != (gotErr == nil) {
+			t.Errorf("words %v letters long not equal to the words of the embedded text", n)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package words
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PastAnswers are the answers of earlier puzzles and the dates they were first used
+type PastAnswers map[string]time.Time
+
+// ParsePastAnswers loads the answers from lines with the date of a puzzle and its answer: "2021-06-19 cigar".
+// Blank lines are ignored.  An error is returned if an answer is not lowercase or a date has more than one answer.
+func ParsePastAnswers(text string) (PastAnswers, error) {
+	pa := make(PastAnswers)
+	dates := make(map[time.Time]string)
+	for i, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(fields) != 2:
+			return nil, fmt.Errorf("line %v: wanted a date and an answer, got %q", i+1, line)
+		}
+		date, err := time.Parse(time.DateOnly, fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %v: parsing date: %w", i+1, err)
+		}
+		w := fields[1]
+		if w != strings.ToLower(w) {
+			return nil, fmt.Errorf("line %v: wanted answer to be lowercase, got %q", i+1, w)
+		}
+		if other, ok := dates[date]; ok {
+			return nil, fmt.Errorf("line %v: %v already has an answer: %q", i+1, fields[0], other)
+		}
+		dates[date] = w
+		if used, ok := pa[w]; !ok || date.Before(used) {
+			pa[w] = date
+		}
+	}
+	return pa, nil
+}
+
+// UsedBefore returns the date the word was first an answer if it was before the day of the date
+func (pa PastAnswers) UsedBefore(w string, date time.Time) (time.Time, bool) {
+	used, ok := pa[w]
+	if !ok || !used.Before(day(date)) {
+		return time.Time{}, false
+	}
+	return used, true
+}
+
+// Remove deletes the words that were answers before the day of the date and returns how many were removed
+func (pa PastAnswers) Remove(m *Words, date time.Time) int {
+	n := 0
+	for w := range *m {
+		if _, ok := pa.UsedBefore(w, date); ok {
+			delete(*m, w)
+			n++
+		}
+	}
+	return n
+}
+
+// day is the start of the calendar day of the date, in the same location as the parsed answer dates
+func day(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
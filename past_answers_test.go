@@ -0,0 +1,124 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParsePastAnswers(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			t.Fatalf("parsing date: %v", err)
+		}
+		return d
+	}
+	tests := []struct {
+		name    string
+		text    string
+		want    PastAnswers
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: PastAnswers{},
+		},
+		{
+			name: "answers",
+			text: "2021-06-19 cigar\n\n2021-06-20 rebut\n",
+			want: PastAnswers{"cigar": date("2021-06-19"), "rebut": date("2021-06-20")},
+		},
+		{
+			name: "reused answer is first date",
+			text: "2024-01-02 cigar\n2021-06-19 cigar\n",
+			want: PastAnswers{"cigar": date("2021-06-19")},
+		},
+		{
+			name:    "missing answer",
+			text:    "2021-06-19",
+			wantErr: true,
+		},
+		{
+			name:    "bad date",
+			text:    "06/19/2021 cigar",
+			wantErr: true,
+		},
+		{
+			name:    "uppercase",
+			text:    "2021-06-19 Cigar",
+			wantErr: true,
+		},
+		{
+			name:    "two answers on a date",
+			text:    "2021-06-19 cigar\n2021-06-19 rebut",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParsePastAnswers(test.text)
+			switch {
+			case test.wantErr:
+				if err == nil {
+					t.Errorf("wanted error")
+				}
+			case err != nil:
+				t.Errorf("unwanted error: %v", err)
+			case !reflect.DeepEqual(test.want, got):
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPastAnswersRemove(t *testing.T) {
+	pa, err := ParsePastAnswers("2021-06-19 cigar\n2021-06-20 rebut\n2021-06-21 sissy")
+	if err != nil {
+		t.Fatalf("unwanted error: %v", err)
+	}
+	tests := []struct {
+		name string
+		date time.Time
+		want Words
+	}{
+		{
+			name: "before all answers",
+			date: time.Date(2021, 6, 19, 23, 0, 0, 0, time.UTC),
+			want: Words{"cigar": {}, "rebut": {}, "sissy": {}, "other": {}},
+		},
+		{
+			name: "answer of the day is possible",
+			date: time.Date(2021, 6, 20, 1, 0, 0, 0, time.FixedZone("east", 10*60*60)),
+			want: Words{"rebut": {}, "sissy": {}, "other": {}},
+		},
+		{
+			name: "after all answers",
+			date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: Words{"other": {}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := Words{"cigar": {}, "rebut": {}, "sissy": {}, "other": {}}
+			n := pa.Remove(&m, test.date)
+			switch {
+			case !reflect.DeepEqual(test.want, m):
+				t.Errorf("not equal:\nwanted: %v\ngot:    %v", test.want, m)
+			case 4-len(test.want) != n:
+				t.Errorf("wanted %v words removed, got %v", 4-len(test.want), n)
+			}
+		})
+	}
+}
+
+func TestPastAnswersUsedBefore(t *testing.T) {
+	pa := PastAnswers{"cigar": time.Date(2021, 6, 19, 0, 0, 0, 0, time.UTC)}
+	date := time.Date(2021, 6, 20, 0, 0, 0, 0, time.UTC)
+	if used, ok := pa.UsedBefore("cigar", date); !ok || !used.Equal(pa["cigar"]) {
+		t.Errorf("wanted cigar to be used on %v, got %v, %v", pa["cigar"], used, ok)
+	}
+	if _, ok := pa.UsedBefore("rebut", date); ok {
+		t.Errorf("wanted rebut to not be used")
+	}
+}
@@ -6,22 +6,56 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/jacobpatterson1549/wordle-cheater/internal/dawg"
 )
 
-//go:embed build/words.txt
-var WordsTextFile string
+//go:embed build/words.dawg
+var wordsDAWG []byte
+
+// EmbeddedLexicon is the embedded word list, indexed from its DAWG the first time it is used.
+var EmbeddedLexicon = sync.OnceValue(func() *Lexicon {
+	l, err := NewLexiconDAWG(wordsDAWG)
+	if err != nil {
+		panic(fmt.Errorf("loading embedded words: %w", err))
+	}
+	return l
+})
+
+// EmbeddedText is the embedded word list, with each word on its own line.
+// The words are embedded as a DAWG, which is smaller than the text, so the text is only decoded the first time it is used.
+var EmbeddedText = sync.OnceValue(func() string {
+	return decodeWords(wordsDAWG)
+})
+
+// decodeWords creates the text of the words of the DAWG
+func decodeWords(data []byte) string {
+	var d dawg.DAWG
+	if err := d.UnmarshalBinary(data); err != nil {
+		panic(fmt.Errorf("decoding embedded words: %w", err))
+	}
+	return d.Text()
+}
 
 // Words is a collection of unique strings
 type Words map[string]struct{}
 
-// New loads the words from the file.
+// DefaultNumLetters is the length of the words in the original Wordle game
+const DefaultNumLetters = 5
+
+// New loads the words that are numLetters long from the file.
 // Words are separated by whitespace (spaces/newlines).
-// An error is returned if any words are not <<numLetters characters long and lowercase.
-func New(a string) (*Words, error) {
+// An error is returned if any of the words are not lowercase.
+func New(a string, numLetters int) (*Words, error) {
+	if numLetters <= 0 {
+		return nil, fmt.Errorf("wanted positive word length, got %v", numLetters)
+	}
 	lines := strings.Fields(a)
 	m := make(Words, len(lines))
 	for _, w := range lines {
-		if len(w) != 5 {
+		if utf8.RuneCountInString(w) != numLetters {
 			continue
 		}
 		if w != strings.ToLower(w) {
@@ -32,6 +66,13 @@ func New(a string) (*Words, error) {
 	return &m, nil
 }
 
+// Lists are the words that can be guessed and the smaller list of words that are likely to be answers.
+// Every answer can also be guessed.
+type Lists struct {
+	Guesses Words
+	Answers Words
+}
+
 // Copy creates a new, identical duplication of the words
 func (m Words) Copy() *Words {
 	m2 := make(Words, len(m))
@@ -52,8 +93,9 @@ func (m Words) sorted() string {
 	return j
 }
 
-// ScanShowPossible prompts to display the words
-func (m Words) ScanShowPossible(rw io.ReadWriter) error {
+// ScanShowPossible prompts to display the words.
+// The words are shown with their probabilities, most likely first, if there are frequencies.
+func (m Words) ScanShowPossible(rw io.ReadWriter, f *Frequencies) error {
 	fmt.Fprintf(rw, "show possible words [Yn]: ")
 	var choice string
 	n, err := fmt.Fscanf(rw, "%s", &choice)
@@ -64,6 +106,15 @@ func (m Words) ScanShowPossible(rw io.ReadWriter) error {
 	if n != 0 && len(choice) > 0 && choice[0] == 'n' {
 		return nil
 	}
+	if f != nil {
+		probabilities := f.Probabilities(m)
+		s := make([]string, len(probabilities))
+		for i, p := range probabilities {
+			s[i] = p.String()
+		}
+		fmt.Fprintf(rw, "remaining valid words: %v\n", strings.Join(s, ", "))
+		return nil
+	}
 	fmt.Fprintf(rw, "remaining valid words: %v\n", m.sorted())
 	return nil
 }
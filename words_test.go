@@ -9,9 +9,10 @@ import (
 
 func TestNew(t *testing.T) {
 	tests := []struct {
-		input   string
-		want    *Words
-		wantErr bool
+		input      string
+		numLetters int
+		want       *Words
+		wantErr    bool
 	}{
 		{
 			want: &Words{}, // no words
@@ -24,17 +25,35 @@ func TestNew(t *testing.T) {
 			input: "apple\nberry",
 			want:  &Words{"apple": {}, "berry": {}},
 		},
+		{
+			input:      "tiny\napple\nberry\nsmall",
+			numLetters: 4,
+			want:       &Words{"tiny": {}},
+		},
+		{
+			input:      "apple",
+			numLetters: -5,
+			wantErr:    true,
+		},
 		{
 			input:   "APPLE", // uppercase
 			wantErr: true,
 		},
+		{
+			input: "größe\nstraße\nniños", // letters are counted, not bytes
+			want:  &Words{"größe": {}, "niños": {}},
+		},
 		{
 			input: "extra\nbreak\nvalid\n\n",
 			want:  &Words{"extra": {}, "break": {}, "valid": {}},
 		},
 	}
 	for i, test := range tests {
-		got, gotErr := New(test.input)
+		numLetters := test.numLetters
+		if numLetters == 0 {
+			numLetters = DefaultNumLetters
+		}
+		got, gotErr := New(test.input, numLetters)
 		switch {
 		case test.wantErr:
 			if gotErr == nil {
@@ -77,6 +96,7 @@ func TestWordsCopy(t *testing.T) {
 func TestWordsScanShowPossible(t *testing.T) {
 	tests := []struct {
 		Words
+		*Frequencies
 		in      string
 		wantOut string
 		wantErr bool
@@ -108,6 +128,12 @@ func TestWordsScanShowPossible(t *testing.T) {
 			in:      "hmmm... no", // first word must be no
 			wantOut: "show possible words [Yn]: remaining valid words: apple\n",
 		},
+		{
+			Words:       Words{"apple": {}, "berry": {}, "cakes": {}},
+			Frequencies: &Frequencies{counts: map[string]float64{"berry": 6, "cakes": 2}, minCount: 2},
+			in:          "y",
+			wantOut:     "show possible words [Yn]: remaining valid words: berry (60%), apple (20%), cakes (20%)\n",
+		},
 	}
 	for i, test := range tests {
 		var buf strings.Builder
@@ -115,7 +141,7 @@ func TestWordsScanShowPossible(t *testing.T) {
 			Reader: bufio.NewReader(strings.NewReader(test.in)),
 			Writer: bufio.NewWriter(&buf),
 		}
-		gotErr := test.Words.ScanShowPossible(rw)
+		gotErr := test.Words.ScanShowPossible(rw, test.Frequencies)
 		rw.Flush()
 		switch {
 		case test.wantErr: